@@ -5,6 +5,8 @@ go 1.13
 require (
 	github.com/dialogs/dialog-go-lib v1.3.0
 	github.com/gogo/protobuf v1.3.1
+	github.com/golang-jwt/jwt v3.2.2+incompatible
+	github.com/golang/protobuf v1.3.2
 	github.com/jessevdk/go-flags v1.4.0
 	github.com/mailru/easyjson v0.7.0
 	github.com/pkg/errors v0.8.1
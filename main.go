@@ -3,6 +3,8 @@ package main
 import (
 	"log"
 	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/dialogs/dialog-go-lib/logger"
 	"github.com/dialogs/dialog-push-service/service"
@@ -39,6 +41,41 @@ func main() {
 		log.Fatal("failed to parse config:", err)
 	}
 
+	go func() {
+		signals := make(chan os.Signal, 1)
+		signal.Notify(signals, syscall.SIGTERM, syscall.SIGINT, syscall.SIGHUP)
+
+		closing := false
+		for sig := range signals {
+			if sig == syscall.SIGHUP {
+				if closing {
+					continue
+				}
+
+				l.Info("reload", zap.Stringer("signal", sig))
+				if err := svc.Reload(); err != nil {
+					l.Error("failed to reload config", zap.Error(err))
+				}
+				continue
+			}
+
+			if closing {
+				// the second signal stops the service without waiting for the pushes in flight
+				l.Warn("forced shutdown", zap.Stringer("signal", sig))
+				os.Exit(1)
+			}
+			closing = true
+
+			l.Info("shutdown", zap.Stringer("signal", sig))
+
+			go func() {
+				if err := svc.Close(); err != nil {
+					l.Error("failed to close service", zap.Error(err))
+				}
+			}()
+		}
+	}()
+
 	if err := svc.Run(); err != nil {
 		log.Println("close service", err)
 	}
@@ -56,6 +56,104 @@ func (PeerType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_09873f3d052f6519, []int{0}
 }
 
+// Priority of a push. The workers send the high-priority pushes first
+type Priority int32
+
+const (
+	// the priority is derived from the body: voip > alerting, encrypted > silent, read
+	PriorityAuto   Priority = 0
+	PriorityLow    Priority = 1
+	PriorityNormal Priority = 2
+	PriorityHigh   Priority = 3
+)
+
+var Priority_name = map[int32]string{
+	0: "PriorityAuto",
+	1: "PriorityLow",
+	2: "PriorityNormal",
+	3: "PriorityHigh",
+}
+
+var Priority_value = map[string]int32{
+	"PriorityAuto":   0,
+	"PriorityLow":    1,
+	"PriorityNormal": 2,
+	"PriorityHigh":   3,
+}
+
+func (Priority) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_09873f3d052f6519, []int{1}
+}
+
+// Delivery status of a notification for a device
+type DeliveryStatus int32
+
+const (
+	StatusUnknown          DeliveryStatus = 0
+	StatusDelivered        DeliveryStatus = 1
+	StatusInvalidToken     DeliveryStatus = 2
+	StatusUnregistered     DeliveryStatus = 3
+	StatusRateLimited      DeliveryStatus = 4
+	StatusPayloadRejected  DeliveryStatus = 5
+	StatusTransientFailure DeliveryStatus = 6
+	// the notification is stored for retry
+	StatusRetryQueued DeliveryStatus = 7
+	// the provider is unavailable: the notification isn't sent while the circuit breaker of the project is open
+	StatusProviderUnavailable DeliveryStatus = 8
+)
+
+var DeliveryStatus_name = map[int32]string{
+	0: "StatusUnknown",
+	1: "StatusDelivered",
+	2: "StatusInvalidToken",
+	3: "StatusUnregistered",
+	4: "StatusRateLimited",
+	5: "StatusPayloadRejected",
+	6: "StatusTransientFailure",
+	7: "StatusRetryQueued",
+	8: "StatusProviderUnavailable",
+}
+
+var DeliveryStatus_value = map[string]int32{
+	"StatusUnknown":             0,
+	"StatusDelivered":           1,
+	"StatusInvalidToken":        2,
+	"StatusUnregistered":        3,
+	"StatusRateLimited":         4,
+	"StatusPayloadRejected":     5,
+	"StatusTransientFailure":    6,
+	"StatusRetryQueued":         7,
+	"StatusProviderUnavailable": 8,
+}
+
+func (DeliveryStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_09873f3d052f6519, []int{2}
+}
+
+// Source of the project settings
+type ProjectSource int32
+
+const (
+	// the config file: the project can't be changed by ProjectAdmin
+	SourceConfig ProjectSource = 0
+	// ProjectAdmin: the project is stored in `projects-dir`
+	SourceAdmin ProjectSource = 1
+)
+
+var ProjectSource_name = map[int32]string{
+	0: "SourceConfig",
+	1: "SourceAdmin",
+}
+
+var ProjectSource_value = map[string]int32{
+	"SourceConfig": 0,
+	"SourceAdmin":  1,
+}
+
+func (ProjectSource) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_09873f3d052f6519, []int{3}
+}
+
 type SilentPush struct {
 }
 
@@ -921,6 +1019,7 @@ type Push struct {
 	Destinations  map[string]*DeviceIdList `protobuf:"bytes,1,rep,name=destinations,proto3" json:"destinations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Body          *PushBody                `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
 	CorrelationId string                   `protobuf:"bytes,3,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+	Priority      Priority                 `protobuf:"varint,4,opt,name=priority,proto3,enum=main.Priority" json:"priority,omitempty"`
 }
 
 func (m *Push) Reset()      { *m = Push{} }
@@ -976,14 +1075,249 @@ func (m *Push) GetCorrelationId() string {
 	return ""
 }
 
+func (m *Push) GetPriority() Priority {
+	if m != nil {
+		return m.Priority
+	}
+	return PriorityAuto
+}
+
+type DeviceResult struct {
+	DeviceId string         `protobuf:"bytes,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	Status   DeliveryStatus `protobuf:"varint,2,opt,name=status,proto3,enum=main.DeliveryStatus" json:"status,omitempty"`
+	// provider message ID: apns-id (APNs), name (FCM), message_id (legacy FCM)
+	MessageId string `protobuf:"bytes,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	// error reason
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// the last time (unix time in milliseconds) when the unregistered device
+	// token was valid. Reported by APNs only, zero otherwise
+	UnregisteredAt int64 `protobuf:"varint,5,opt,name=unregistered_at,json=unregisteredAt,proto3" json:"unregistered_at,omitempty"`
+}
+
+func (m *DeviceResult) Reset()      { *m = DeviceResult{} }
+func (*DeviceResult) ProtoMessage() {}
+func (*DeviceResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09873f3d052f6519, []int{12}
+}
+func (m *DeviceResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeviceResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeviceResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeviceResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceResult.Merge(m, src)
+}
+func (m *DeviceResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeviceResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeviceResult proto.InternalMessageInfo
+
+func (m *DeviceResult) GetDeviceId() string {
+	if m != nil {
+		return m.DeviceId
+	}
+	return ""
+}
+
+func (m *DeviceResult) GetStatus() DeliveryStatus {
+	if m != nil {
+		return m.Status
+	}
+	return StatusUnknown
+}
+
+func (m *DeviceResult) GetMessageId() string {
+	if m != nil {
+		return m.MessageId
+	}
+	return ""
+}
+
+func (m *DeviceResult) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *DeviceResult) GetUnregisteredAt() int64 {
+	if m != nil {
+		return m.UnregisteredAt
+	}
+	return 0
+}
+
+type DeviceResultList struct {
+	Results []*DeviceResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (m *DeviceResultList) Reset()      { *m = DeviceResultList{} }
+func (*DeviceResultList) ProtoMessage() {}
+func (*DeviceResultList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09873f3d052f6519, []int{13}
+}
+func (m *DeviceResultList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeviceResultList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeviceResultList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeviceResultList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceResultList.Merge(m, src)
+}
+func (m *DeviceResultList) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeviceResultList) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceResultList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeviceResultList proto.InternalMessageInfo
+
+func (m *DeviceResultList) GetResults() []*DeviceResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// Canonical device tokens: old token -> new token
+type TokenReplacements struct {
+	Tokens map[string]string `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *TokenReplacements) Reset()      { *m = TokenReplacements{} }
+func (*TokenReplacements) ProtoMessage() {}
+func (*TokenReplacements) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09873f3d052f6519, []int{14}
+}
+func (m *TokenReplacements) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenReplacements) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenReplacements.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenReplacements) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenReplacements.Merge(m, src)
+}
+func (m *TokenReplacements) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenReplacements) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenReplacements.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenReplacements proto.InternalMessageInfo
+
+func (m *TokenReplacements) GetTokens() map[string]string {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+// The error of the push: the push isn't sent
+type PushError struct {
+	// gRPC status code
+	Code    int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *PushError) Reset()      { *m = PushError{} }
+func (*PushError) ProtoMessage() {}
+func (*PushError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09873f3d052f6519, []int{15}
+}
+func (m *PushError) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PushError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PushError.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PushError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushError.Merge(m, src)
+}
+func (m *PushError) XXX_Size() int {
+	return m.Size()
+}
+func (m *PushError) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushError.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PushError proto.InternalMessageInfo
+
+func (m *PushError) GetCode() int32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *PushError) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
 type Response struct {
+	// invalid and unregistered device tokens by project ID
 	ProjectInvalidations map[string]*DeviceIdList `protobuf:"bytes,1,rep,name=project_invalidations,json=projectInvalidations,proto3" json:"project_invalidations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// delivery results by project ID
+	Results map[string]*DeviceResultList `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// changed device tokens by project ID (legacy FCM only)
+	ProjectReplacements map[string]*TokenReplacements `protobuf:"bytes,3,rep,name=project_replacements,json=projectReplacements,proto3" json:"project_replacements,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// correlation ID of the push
+	CorrelationId string `protobuf:"bytes,4,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+	// the error of the streamed push (PushStream only)
+	Error *PushError `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
 }
 
 func (m *Response) Reset()      { *m = Response{} }
 func (*Response) ProtoMessage() {}
 func (*Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_09873f3d052f6519, []int{12}
+	return fileDescriptor_09873f3d052f6519, []int{16}
 }
 func (m *Response) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1019,13 +1353,41 @@ func (m *Response) GetProjectInvalidations() map[string]*DeviceIdList {
 	return nil
 }
 
+func (m *Response) GetResults() map[string]*DeviceResultList {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *Response) GetProjectReplacements() map[string]*TokenReplacements {
+	if m != nil {
+		return m.ProjectReplacements
+	}
+	return nil
+}
+
+func (m *Response) GetCorrelationId() string {
+	if m != nil {
+		return m.CorrelationId
+	}
+	return ""
+}
+
+func (m *Response) GetError() *PushError {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
 type PingRequest struct {
 }
 
 func (m *PingRequest) Reset()      { *m = PingRequest{} }
 func (*PingRequest) ProtoMessage() {}
 func (*PingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_09873f3d052f6519, []int{13}
+	return fileDescriptor_09873f3d052f6519, []int{17}
 }
 func (m *PingRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1060,7 +1422,7 @@ type PongResponse struct {
 func (m *PongResponse) Reset()      { *m = PongResponse{} }
 func (*PongResponse) ProtoMessage() {}
 func (*PongResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_09873f3d052f6519, []int{14}
+	return fileDescriptor_09873f3d052f6519, []int{18}
 }
 func (m *PongResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1089,324 +1451,785 @@ func (m *PongResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PongResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterEnum("main.PeerType", PeerType_name, PeerType_value)
-	proto.RegisterType((*SilentPush)(nil), "main.SilentPush")
-	proto.RegisterType((*Localizeable)(nil), "main.Localizeable")
-	proto.RegisterType((*Peer)(nil), "main.Peer")
-	proto.RegisterType((*OutPeer)(nil), "main.OutPeer")
-	proto.RegisterType((*MergeCallModel)(nil), "main.MergeCallModel")
-	proto.RegisterType((*AlertingPush)(nil), "main.AlertingPush")
-	proto.RegisterType((*VoipPush)(nil), "main.VoipPush")
-	proto.RegisterType((*EncryptedPush)(nil), "main.EncryptedPush")
-	proto.RegisterType((*ReadPush)(nil), "main.ReadPush")
-	proto.RegisterType((*PushBody)(nil), "main.PushBody")
-	proto.RegisterType((*DeviceIdList)(nil), "main.DeviceIdList")
-	proto.RegisterType((*Push)(nil), "main.Push")
-	proto.RegisterMapType((map[string]*DeviceIdList)(nil), "main.Push.DestinationsEntry")
-	proto.RegisterType((*Response)(nil), "main.Response")
-	proto.RegisterMapType((map[string]*DeviceIdList)(nil), "main.Response.ProjectInvalidationsEntry")
-	proto.RegisterType((*PingRequest)(nil), "main.PingRequest")
-	proto.RegisterType((*PongResponse)(nil), "main.PongResponse")
+// Credentials of an APNs project: the certificate or the token signing key
+type AppleCredentials struct {
+	// certificate and private key in pem format
+	Pem []byte `protobuf:"bytes,1,opt,name=pem,proto3" json:"pem,omitempty"`
+	// token-based authentication: the signing key (.p8), key identifier and team identifier
+	Key    []byte `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	KeyId  string `protobuf:"bytes,3,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	TeamId string `protobuf:"bytes,4,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	// VoIP pushes are allowed (token-based authentication only)
+	Voip bool `protobuf:"varint,5,opt,name=voip,proto3" json:"voip,omitempty"`
 }
 
-func init() { proto.RegisterFile("push_service.proto", fileDescriptor_09873f3d052f6519) }
+func (m *AppleCredentials) Reset()      { *m = AppleCredentials{} }
+func (*AppleCredentials) ProtoMessage() {}
+func (*AppleCredentials) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09873f3d052f6519, []int{19}
+}
+func (m *AppleCredentials) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AppleCredentials) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AppleCredentials.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AppleCredentials) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppleCredentials.Merge(m, src)
+}
+func (m *AppleCredentials) XXX_Size() int {
+	return m.Size()
+}
+func (m *AppleCredentials) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppleCredentials.DiscardUnknown(m)
+}
 
-var fileDescriptor_09873f3d052f6519 = []byte{
-	// 1301 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4f, 0x8f, 0xdb, 0x44,
-	0x1b, 0x8f, 0x37, 0xff, 0x9f, 0x38, 0xe9, 0x76, 0xba, 0xab, 0xa6, 0xab, 0xf7, 0xf5, 0xbb, 0xf5,
-	0x0b, 0xea, 0xaa, 0x50, 0x2f, 0x6a, 0x2f, 0xa5, 0xaa, 0x04, 0x5d, 0xb6, 0x62, 0x23, 0x5a, 0x48,
-	0xbd, 0x55, 0x0f, 0x20, 0x64, 0x4d, 0xec, 0x07, 0xef, 0x50, 0xc7, 0xe3, 0x7a, 0x26, 0x81, 0x70,
-	0xe2, 0x23, 0x20, 0x0e, 0x7c, 0x01, 0x2e, 0x7c, 0x00, 0x3e, 0x03, 0xe2, 0xd8, 0x63, 0x8f, 0x34,
-	0xbd, 0xf4, 0xd8, 0x0b, 0x77, 0x34, 0x33, 0xf6, 0xc6, 0x11, 0x5d, 0x84, 0xe0, 0x94, 0x3c, 0xff,
-	0xe6, 0xf7, 0xcc, 0xef, 0xf9, 0x33, 0x06, 0x92, 0xcd, 0xc4, 0x49, 0x20, 0x30, 0x9f, 0xb3, 0x10,
-	0xbd, 0x2c, 0xe7, 0x92, 0x93, 0xc6, 0x94, 0xb2, 0x74, 0xc7, 0x89, 0x39, 0x8f, 0x13, 0xdc, 0xd7,
-	0xba, 0xc9, 0xec, 0x8b, 0xfd, 0xaf, 0x72, 0x9a, 0x65, 0x98, 0x0b, 0xe3, 0xb5, 0xb3, 0x2d, 0x42,
-	0x9a, 0xd0, 0x6c, 0xb2, 0x5f, 0xfc, 0x1a, 0xb5, 0x6b, 0x03, 0x1c, 0xb3, 0x04, 0x53, 0x39, 0x9e,
-	0x89, 0x13, 0xf7, 0x00, 0xec, 0x7b, 0x3c, 0xa4, 0x09, 0xfb, 0x06, 0xe9, 0x24, 0x41, 0x72, 0x11,
-	0xda, 0x09, 0x0f, 0x83, 0xc7, 0xb8, 0x18, 0x5a, 0xbb, 0xd6, 0x5e, 0xd7, 0x6f, 0x25, 0x3c, 0xfc,
-	0x08, 0x17, 0xe4, 0x12, 0x74, 0x94, 0x81, 0xe6, 0xb1, 0x18, 0x6e, 0xec, 0xd6, 0xf7, 0xba, 0xbe,
-	0x72, 0xbc, 0x93, 0xc7, 0xc2, 0x7d, 0x00, 0x8d, 0x31, 0x62, 0x4e, 0x5c, 0x68, 0xc8, 0x45, 0x86,
-	0x3a, 0x70, 0x70, 0x7d, 0xe0, 0xa9, 0x2c, 0x3d, 0x65, 0x79, 0xb8, 0xc8, 0xd0, 0xd7, 0x36, 0x32,
-	0x80, 0x0d, 0x16, 0x0d, 0x37, 0x76, 0xad, 0xbd, 0xa6, 0xbf, 0xc1, 0x22, 0xb2, 0x0d, 0x2d, 0x21,
-	0xf3, 0x80, 0x45, 0xc3, 0xba, 0x86, 0x6b, 0x0a, 0x99, 0x8f, 0x22, 0x57, 0x42, 0xfb, 0x93, 0x99,
-	0xfc, 0xc7, 0xa7, 0x3a, 0x00, 0x34, 0x0c, 0x51, 0x88, 0x23, 0x2a, 0x4e, 0xf4, 0xc9, 0x75, 0xbf,
-	0xa2, 0xa9, 0xa0, 0x36, 0xaa, 0xa8, 0x37, 0x61, 0x70, 0x1f, 0xf3, 0x18, 0x3f, 0xa0, 0x49, 0x72,
-	0x9f, 0x47, 0x98, 0x90, 0x4d, 0xa8, 0xaf, 0xa8, 0x50, 0x7f, 0xc9, 0x16, 0x34, 0xa7, 0xca, 0x47,
-	0xa3, 0x75, 0x7c, 0x23, 0xb8, 0x3f, 0xd6, 0xc1, 0xbe, 0x93, 0x60, 0x2e, 0x59, 0x1a, 0x2b, 0x5e,
-	0xc9, 0x2d, 0x18, 0x68, 0xba, 0x94, 0x2e, 0x98, 0xf0, 0xc8, 0x9c, 0xd1, 0xbb, 0x4e, 0x4c, 0xfe,
-	0x55, 0xce, 0x8f, 0x6a, 0xbe, 0xad, 0xa8, 0x54, 0xae, 0x07, 0x3c, 0x5a, 0x90, 0xb7, 0xe1, 0xbc,
-	0x60, 0xd3, 0x2c, 0xc1, 0x6a, 0xb8, 0x82, 0xeb, 0x1e, 0xd5, 0xfc, 0x73, 0xc6, 0xb4, 0xf2, 0xbe,
-	0x0d, 0xe7, 0x56, 0x48, 0x92, 0xc9, 0x04, 0xf5, 0x85, 0x5f, 0x0f, 0x65, 0xf9, 0xfd, 0x12, 0xea,
-	0xa1, 0x72, 0x25, 0x1e, 0x90, 0x35, 0x2c, 0x73, 0x80, 0x66, 0xe5, 0xc8, 0xf2, 0x37, 0x2b, 0x60,
-	0xc6, 0x7f, 0x0b, 0x9a, 0x13, 0x1a, 0xc5, 0x38, 0x6c, 0x69, 0xb2, 0x8d, 0x40, 0x1c, 0x68, 0x64,
-	0x88, 0xf9, 0xb0, 0xad, 0x81, 0x61, 0x55, 0x23, 0x5f, 0xeb, 0x89, 0x07, 0xf5, 0x29, 0x8b, 0x86,
-	0x1d, 0x6d, 0xfe, 0x8f, 0x67, 0x1a, 0xd7, 0x2b, 0x1b, 0xd7, 0x3b, 0x96, 0x39, 0x4b, 0xe3, 0x47,
-	0x34, 0x99, 0xa1, 0xaf, 0x1c, 0xc9, 0x4d, 0xe8, 0x84, 0x54, 0x62, 0xcc, 0xf3, 0xc5, 0xb0, 0xfb,
-	0x37, 0x82, 0x4e, 0xbd, 0x0f, 0x6c, 0x80, 0x15, 0x69, 0x07, 0x7d, 0xe8, 0x55, 0xae, 0xe5, 0xfe,
-	0x5c, 0x87, 0xce, 0x23, 0xce, 0x32, 0x5d, 0xa1, 0x8b, 0xd0, 0x0e, 0x69, 0x92, 0xa8, 0x26, 0xb0,
-	0x74, 0x83, 0xb4, 0x94, 0x38, 0x8a, 0xc8, 0xff, 0xa1, 0x4f, 0xa5, 0xc4, 0x69, 0x26, 0x03, 0x96,
-	0x46, 0xf8, 0x75, 0xd1, 0x57, 0x76, 0xa1, 0x1c, 0x29, 0x1d, 0xb9, 0x0c, 0x76, 0xc4, 0x44, 0x96,
-	0xd0, 0x45, 0x90, 0xd2, 0x29, 0x16, 0xdd, 0xdb, 0x2b, 0x74, 0x1f, 0xd3, 0x29, 0x92, 0x5d, 0xb0,
-	0x71, 0x8e, 0xa9, 0x0c, 0x26, 0x33, 0xb1, 0x6a, 0x35, 0xd0, 0xba, 0x83, 0x99, 0x18, 0x45, 0xa7,
-	0xb4, 0x35, 0xcf, 0xa0, 0xed, 0x7f, 0xd0, 0x9b, 0x65, 0x11, 0x95, 0x18, 0xe8, 0x09, 0x68, 0x99,
-	0x03, 0x8c, 0x4a, 0x75, 0x3f, 0xb9, 0x02, 0xe7, 0x14, 0x22, 0x17, 0x34, 0x09, 0x72, 0xa4, 0x82,
-	0xa7, 0xba, 0x04, 0x5d, 0x7f, 0x50, 0xaa, 0x7d, 0xad, 0x25, 0x57, 0xa0, 0xcd, 0xcd, 0x3c, 0x15,
-	0x45, 0xe8, 0x1b, 0xb0, 0x62, 0xc8, 0xfc, 0xd2, 0xaa, 0xea, 0x3b, 0x67, 0x11, 0x72, 0x4d, 0x7b,
-	0xc7, 0x37, 0x02, 0x71, 0xa0, 0x57, 0x70, 0x15, 0x08, 0x99, 0x0f, 0x41, 0x63, 0x74, 0x0d, 0x5f,
-	0xc7, 0x52, 0x47, 0x49, 0xfe, 0x18, 0xd3, 0x61, 0xcf, 0x8c, 0x93, 0x16, 0xc8, 0x0e, 0x74, 0x30,
-	0x8d, 0x32, 0xce, 0x52, 0x39, 0xb4, 0xb5, 0xe1, 0x54, 0x26, 0x57, 0xcb, 0x31, 0xea, 0xeb, 0x74,
-	0xb6, 0x4c, 0x3a, 0xeb, 0xd3, 0x57, 0x0e, 0xd7, 0xf7, 0x16, 0xf4, 0xef, 0xa6, 0x61, 0xbe, 0xc8,
-	0x24, 0x46, 0xba, 0x76, 0x87, 0xb0, 0x95, 0xcd, 0x26, 0x09, 0x2b, 0xda, 0x9e, 0xa5, 0x71, 0xa0,
-	0xd6, 0xe4, 0xfa, 0x8c, 0x55, 0xe7, 0xd1, 0x27, 0xc6, 0x7f, 0x6d, 0x46, 0xdf, 0x84, 0x01, 0x96,
-	0xc7, 0x06, 0x11, 0x95, 0x54, 0x57, 0xda, 0xf6, 0xfb, 0xa7, 0xda, 0x43, 0x2a, 0xa9, 0xba, 0x5c,
-	0xca, 0xd3, 0x10, 0x8b, 0x3d, 0x62, 0x04, 0x77, 0x0c, 0x1d, 0x1f, 0xa9, 0x49, 0xa7, 0xac, 0xa3,
-	0x75, 0x46, 0x1d, 0xdf, 0x80, 0x41, 0x42, 0x85, 0x54, 0x25, 0xd2, 0x40, 0x66, 0x79, 0xd4, 0x7d,
-	0x5b, 0x69, 0xd5, 0x29, 0x87, 0x54, 0xa2, 0xfb, 0xfb, 0x06, 0x74, 0xd4, 0x71, 0x7a, 0xaa, 0x2f,
-	0x83, 0x1d, 0xf2, 0x24, 0xa1, 0x99, 0xc0, 0xca, 0x32, 0xee, 0x95, 0x3a, 0xb5, 0x91, 0x77, 0xc1,
-	0x96, 0x6c, 0x8a, 0x81, 0xe4, 0x41, 0xc2, 0xe6, 0x58, 0xb4, 0x29, 0x28, 0xdd, 0x43, 0x7e, 0x8f,
-	0xcd, 0x51, 0x6d, 0x2f, 0x81, 0x4f, 0x74, 0xde, 0x4d, 0x5f, 0xfd, 0x25, 0x37, 0xa0, 0x27, 0xf4,
-	0xf2, 0x37, 0x7c, 0x35, 0x74, 0xc2, 0x9b, 0x26, 0xe1, 0xd5, 0xab, 0x70, 0x54, 0xf3, 0x41, 0x9c,
-	0x4a, 0xe4, 0x5d, 0xe8, 0xaf, 0xd3, 0xdc, 0x3c, 0x8b, 0x66, 0xb5, 0xca, 0x68, 0x95, 0xe2, 0x6b,
-	0xd0, 0x9d, 0x73, 0x96, 0x99, 0xb0, 0x96, 0x0e, 0x2b, 0x36, 0x78, 0x39, 0x87, 0x47, 0x35, 0xbf,
-	0x33, 0x2f, 0x67, 0xf2, 0x76, 0xb5, 0x22, 0x3a, 0xc6, 0x6c, 0x94, 0x0b, 0x26, 0x66, 0xad, 0x09,
-	0x8e, 0x6a, 0x95, 0x42, 0x95, 0x60, 0x9a, 0x61, 0x1d, 0xd8, 0xa9, 0x82, 0x95, 0x95, 0x52, 0x60,
-	0x79, 0xf1, 0xff, 0xa0, 0x05, 0x0d, 0xb5, 0x24, 0xdc, 0x6b, 0x60, 0x1f, 0xa2, 0x7a, 0x5d, 0x47,
-	0xd1, 0x3d, 0x26, 0x24, 0xf9, 0x2f, 0x40, 0xa4, 0xe5, 0x80, 0x45, 0x62, 0x68, 0xe9, 0xb7, 0xae,
-	0x1b, 0x15, 0x1e, 0xc2, 0x7d, 0x69, 0x41, 0x43, 0xc3, 0xbd, 0x0f, 0x76, 0x84, 0x42, 0xb2, 0x94,
-	0x4a, 0xc6, 0x53, 0xe3, 0xa9, 0x16, 0x95, 0xa9, 0xfe, 0x4c, 0x9c, 0x78, 0x87, 0x15, 0xf3, 0xdd,
-	0x54, 0xe6, 0x0b, 0x7f, 0x2d, 0x42, 0x3d, 0x6d, 0xa7, 0xbb, 0xfd, 0x34, 0xd7, 0xb2, 0x05, 0x7c,
-	0x6d, 0x53, 0x4d, 0x1a, 0xf2, 0x3c, 0xc7, 0x44, 0xc7, 0xac, 0x1e, 0xca, 0x7e, 0x45, 0x3b, 0x8a,
-	0x76, 0x8e, 0xe1, 0xfc, 0x9f, 0xd0, 0x5e, 0xf3, 0x7a, 0xed, 0x41, 0x73, 0xae, 0x36, 0x66, 0x01,
-	0x59, 0x94, 0xb0, 0x7a, 0x7d, 0xdf, 0x38, 0xdc, 0xda, 0xb8, 0x69, 0xb9, 0xbf, 0x58, 0xaa, 0xc9,
-	0x45, 0xc6, 0x53, 0x81, 0xe4, 0x73, 0xd8, 0xce, 0x72, 0xfe, 0x25, 0x86, 0x6a, 0x2d, 0xce, 0x69,
-	0xc2, 0xa2, 0xb5, 0x7b, 0xef, 0x95, 0x4c, 0x1b, 0x77, 0x6f, 0x6c, 0x7c, 0x47, 0x55, 0x57, 0xc3,
-	0xc1, 0x56, 0xf6, 0x1a, 0xd3, 0xce, 0x67, 0x70, 0xe9, 0xcc, 0x90, 0x7f, 0x7d, 0x91, 0x3e, 0xf4,
-	0xc6, 0x2c, 0x8d, 0x7d, 0x7c, 0x32, 0x43, 0x21, 0xdd, 0x01, 0xd8, 0x63, 0xae, 0x44, 0x93, 0xeb,
-	0xd5, 0xb7, 0xa0, 0x53, 0x7e, 0x50, 0x90, 0x1e, 0xb4, 0xc7, 0x39, 0x9b, 0x53, 0x89, 0x9b, 0x35,
-	0xd2, 0x85, 0xe6, 0x87, 0x39, 0x9f, 0x65, 0x9b, 0x16, 0x69, 0x43, 0xfd, 0x78, 0x34, 0xde, 0xdc,
-	0xb8, 0xfe, 0x83, 0x05, 0x6d, 0x55, 0x23, 0x96, 0xc6, 0x64, 0x1f, 0x1a, 0xea, 0x5c, 0x72, 0xbe,
-	0x28, 0xdd, 0x0a, 0x63, 0xa7, 0xc8, 0xa8, 0x8a, 0xe3, 0xd6, 0x88, 0x07, 0xa0, 0x62, 0x8f, 0x65,
-	0x8e, 0x74, 0x4a, 0x60, 0x55, 0xf1, 0x9d, 0xc1, 0x3a, 0x7f, 0x6e, 0x6d, 0xcf, 0x7a, 0xc7, 0x22,
-	0x57, 0xd5, 0xc7, 0x5a, 0x1a, 0x27, 0xa8, 0x3b, 0xee, 0x2f, 0xfd, 0x0f, 0x1e, 0x2c, 0xdf, 0xdb,
-	0x86, 0x0b, 0x6c, 0xea, 0x45, 0x49, 0xec, 0xa9, 0x19, 0xf0, 0x8a, 0xaf, 0xc6, 0xa7, 0xcf, 0x9d,
-	0xda, 0xb3, 0xe7, 0x4e, 0xed, 0xd5, 0x73, 0xc7, 0xfa, 0x76, 0xe9, 0x58, 0x3f, 0x2d, 0x1d, 0xeb,
-	0xd7, 0xa5, 0x63, 0x3d, 0x5d, 0x3a, 0xd6, 0x6f, 0x4b, 0xc7, 0x7a, 0xb9, 0x74, 0x6a, 0xaf, 0x96,
-	0x8e, 0xf5, 0xdd, 0x0b, 0xa7, 0xf6, 0xf4, 0x85, 0x53, 0x7b, 0xf6, 0xc2, 0xa9, 0x7d, 0x5a, 0xa7,
-	0x19, 0x9b, 0xb4, 0xf4, 0x6b, 0x7b, 0xe3, 0x8f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x98, 0x92, 0x02,
-	0x4e, 0x85, 0x0a, 0x00, 0x00,
+var xxx_messageInfo_AppleCredentials proto.InternalMessageInfo
+
+func (m *AppleCredentials) GetPem() []byte {
+	if m != nil {
+		return m.Pem
+	}
+	return nil
 }
 
-func (x PeerType) String() string {
-	s, ok := PeerType_name[int32(x)]
-	if ok {
-		return s
+func (m *AppleCredentials) GetKey() []byte {
+	if m != nil {
+		return m.Key
 	}
-	return strconv.Itoa(int(x))
+	return nil
 }
-func (this *SilentPush) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *AppleCredentials) GetKeyId() string {
+	if m != nil {
+		return m.KeyId
 	}
+	return ""
+}
 
-	that1, ok := that.(*SilentPush)
-	if !ok {
-		that2, ok := that.(SilentPush)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *AppleCredentials) GetTeamId() string {
+	if m != nil {
+		return m.TeamId
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	return true
+	return ""
 }
-func (this *Localizeable) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *AppleCredentials) GetVoip() bool {
+	if m != nil {
+		return m.Voip
 	}
+	return false
+}
 
-	that1, ok := that.(*Localizeable)
-	if !ok {
-		that2, ok := that.(Localizeable)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// Credentials of a FCM HTTP v1 project
+type FcmCredentials struct {
+	// service account key in json format
+	ServiceAccount []byte `protobuf:"bytes,1,opt,name=service_account,json=serviceAccount,proto3" json:"service_account,omitempty"`
+}
+
+func (m *FcmCredentials) Reset()      { *m = FcmCredentials{} }
+func (*FcmCredentials) ProtoMessage() {}
+func (*FcmCredentials) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09873f3d052f6519, []int{20}
+}
+func (m *FcmCredentials) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FcmCredentials) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FcmCredentials.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.LocKey != that1.LocKey {
-		return false
-	}
-	if len(this.LocArgs) != len(that1.LocArgs) {
-		return false
+}
+func (m *FcmCredentials) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FcmCredentials.Merge(m, src)
+}
+func (m *FcmCredentials) XXX_Size() int {
+	return m.Size()
+}
+func (m *FcmCredentials) XXX_DiscardUnknown() {
+	xxx_messageInfo_FcmCredentials.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FcmCredentials proto.InternalMessageInfo
+
+func (m *FcmCredentials) GetServiceAccount() []byte {
+	if m != nil {
+		return m.ServiceAccount
 	}
-	for i := range this.LocArgs {
-		if this.LocArgs[i] != that1.LocArgs[i] {
-			return false
+	return nil
+}
+
+// Credentials of a legacy FCM HTTP project
+type GoogleCredentials struct {
+	ServerKey string `protobuf:"bytes,1,opt,name=server_key,json=serverKey,proto3" json:"server_key,omitempty"`
+}
+
+func (m *GoogleCredentials) Reset()      { *m = GoogleCredentials{} }
+func (*GoogleCredentials) ProtoMessage() {}
+func (*GoogleCredentials) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09873f3d052f6519, []int{21}
+}
+func (m *GoogleCredentials) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GoogleCredentials) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GoogleCredentials.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *Peer) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *GoogleCredentials) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GoogleCredentials.Merge(m, src)
+}
+func (m *GoogleCredentials) XXX_Size() int {
+	return m.Size()
+}
+func (m *GoogleCredentials) XXX_DiscardUnknown() {
+	xxx_messageInfo_GoogleCredentials.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GoogleCredentials proto.InternalMessageInfo
+
+func (m *GoogleCredentials) GetServerKey() string {
+	if m != nil {
+		return m.ServerKey
 	}
+	return ""
+}
 
-	that1, ok := that.(*Peer)
-	if !ok {
-		that2, ok := that.(Peer)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// Settings and credentials of the project (as in the config file)
+type ProjectSpec struct {
+	ProjectId   string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Sandbox     bool   `protobuf:"varint,2,opt,name=sandbox,proto3" json:"sandbox,omitempty"`
+	NopMode     bool   `protobuf:"varint,3,opt,name=nop_mode,json=nopMode,proto3" json:"nop_mode,omitempty"`
+	AllowAlerts bool   `protobuf:"varint,4,opt,name=allow_alerts,json=allowAlerts,proto3" json:"allow_alerts,omitempty"`
+	// apns-topic and the default sound (APNs only)
+	Topic string `protobuf:"bytes,5,opt,name=topic,proto3" json:"topic,omitempty"`
+	Sound string `protobuf:"bytes,6,opt,name=sound,proto3" json:"sound,omitempty"`
+	// Types that are valid to be assigned to Credentials:
+	//	*ProjectSpec_Apple
+	//	*ProjectSpec_Fcm
+	//	*ProjectSpec_Google
+	Credentials isProjectSpec_Credentials `protobuf_oneof:"credentials"`
+}
+
+func (m *ProjectSpec) Reset()      { *m = ProjectSpec{} }
+func (*ProjectSpec) ProtoMessage() {}
+func (*ProjectSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09873f3d052f6519, []int{22}
+}
+func (m *ProjectSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProjectSpec.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Type != that1.Type {
-		return false
+}
+func (m *ProjectSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectSpec.Merge(m, src)
+}
+func (m *ProjectSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectSpec proto.InternalMessageInfo
+
+type isProjectSpec_Credentials interface {
+	isProjectSpec_Credentials()
+	Equal(interface{}) bool
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type ProjectSpec_Apple struct {
+	Apple *AppleCredentials `protobuf:"bytes,7,opt,name=apple,proto3,oneof" json:"apple,omitempty"`
+}
+type ProjectSpec_Fcm struct {
+	Fcm *FcmCredentials `protobuf:"bytes,8,opt,name=fcm,proto3,oneof" json:"fcm,omitempty"`
+}
+type ProjectSpec_Google struct {
+	Google *GoogleCredentials `protobuf:"bytes,9,opt,name=google,proto3,oneof" json:"google,omitempty"`
+}
+
+func (*ProjectSpec_Apple) isProjectSpec_Credentials()  {}
+func (*ProjectSpec_Fcm) isProjectSpec_Credentials()    {}
+func (*ProjectSpec_Google) isProjectSpec_Credentials() {}
+
+func (m *ProjectSpec) GetCredentials() isProjectSpec_Credentials {
+	if m != nil {
+		return m.Credentials
 	}
-	if this.Id != that1.Id {
-		return false
+	return nil
+}
+
+func (m *ProjectSpec) GetProjectId() string {
+	if m != nil {
+		return m.ProjectId
 	}
-	if this.StrId != that1.StrId {
-		return false
+	return ""
+}
+
+func (m *ProjectSpec) GetSandbox() bool {
+	if m != nil {
+		return m.Sandbox
 	}
-	return true
+	return false
 }
-func (this *OutPeer) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ProjectSpec) GetNopMode() bool {
+	if m != nil {
+		return m.NopMode
 	}
+	return false
+}
 
-	that1, ok := that.(*OutPeer)
-	if !ok {
-		that2, ok := that.(OutPeer)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *ProjectSpec) GetAllowAlerts() bool {
+	if m != nil {
+		return m.AllowAlerts
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return false
+}
+
+func (m *ProjectSpec) GetTopic() string {
+	if m != nil {
+		return m.Topic
 	}
-	if this.Type != that1.Type {
-		return false
+	return ""
+}
+
+func (m *ProjectSpec) GetSound() string {
+	if m != nil {
+		return m.Sound
 	}
-	if this.Id != that1.Id {
-		return false
+	return ""
+}
+
+func (m *ProjectSpec) GetApple() *AppleCredentials {
+	if x, ok := m.GetCredentials().(*ProjectSpec_Apple); ok {
+		return x.Apple
 	}
-	if this.AccessHash != that1.AccessHash {
-		return false
+	return nil
+}
+
+func (m *ProjectSpec) GetFcm() *FcmCredentials {
+	if x, ok := m.GetCredentials().(*ProjectSpec_Fcm); ok {
+		return x.Fcm
 	}
-	if this.StrId != that1.StrId {
-		return false
+	return nil
+}
+
+func (m *ProjectSpec) GetGoogle() *GoogleCredentials {
+	if x, ok := m.GetCredentials().(*ProjectSpec_Google); ok {
+		return x.Google
 	}
-	return true
+	return nil
 }
-func (this *MergeCallModel) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ProjectSpec) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ProjectSpec_Apple)(nil),
+		(*ProjectSpec_Fcm)(nil),
+		(*ProjectSpec_Google)(nil),
 	}
+}
 
-	that1, ok := that.(*MergeCallModel)
-	if !ok {
-		that2, ok := that.(MergeCallModel)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type ProjectInfo struct {
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// kind of the provider: apple, fcm or google
+	Kind    string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Sandbox bool   `protobuf:"varint,3,opt,name=sandbox,proto3" json:"sandbox,omitempty"`
+	NopMode bool   `protobuf:"varint,4,opt,name=nop_mode,json=nopMode,proto3" json:"nop_mode,omitempty"`
+	// the provider is available: the circuit breaker of the project isn't open
+	Healthy bool          `protobuf:"varint,5,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	Source  ProjectSource `protobuf:"varint,6,opt,name=source,proto3,enum=main.ProjectSource" json:"source,omitempty"`
+}
+
+func (m *ProjectInfo) Reset()      { *m = ProjectInfo{} }
+func (*ProjectInfo) ProtoMessage() {}
+func (*ProjectInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09873f3d052f6519, []int{23}
+}
+func (m *ProjectInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProjectInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Key != that1.Key {
-		return false
+}
+func (m *ProjectInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectInfo.Merge(m, src)
+}
+func (m *ProjectInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectInfo proto.InternalMessageInfo
+
+func (m *ProjectInfo) GetProjectId() string {
+	if m != nil {
+		return m.ProjectId
 	}
-	if this.Merge != that1.Merge {
-		return false
+	return ""
+}
+
+func (m *ProjectInfo) GetKind() string {
+	if m != nil {
+		return m.Kind
 	}
-	return true
+	return ""
 }
-func (this *AlertingPush) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ProjectInfo) GetSandbox() bool {
+	if m != nil {
+		return m.Sandbox
 	}
+	return false
+}
 
-	that1, ok := that.(*AlertingPush)
-	if !ok {
-		that2, ok := that.(AlertingPush)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *ProjectInfo) GetNopMode() bool {
+	if m != nil {
+		return m.NopMode
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return false
+}
+
+func (m *ProjectInfo) GetHealthy() bool {
+	if m != nil {
+		return m.Healthy
 	}
-	if that1.AlertBody == nil {
-		if this.AlertBody != nil {
-			return false
+	return false
+}
+
+func (m *ProjectInfo) GetSource() ProjectSource {
+	if m != nil {
+		return m.Source
+	}
+	return SourceConfig
+}
+
+type ListProjectsRequest struct {
+}
+
+func (m *ListProjectsRequest) Reset()      { *m = ListProjectsRequest{} }
+func (*ListProjectsRequest) ProtoMessage() {}
+func (*ListProjectsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09873f3d052f6519, []int{24}
+}
+func (m *ListProjectsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListProjectsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListProjectsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-	} else if this.AlertBody == nil {
-		return false
-	} else if !this.AlertBody.Equal(that1.AlertBody) {
-		return false
+		return b[:n], nil
 	}
-	if that1.AlertTitle == nil {
-		if this.AlertTitle != nil {
-			return false
+}
+func (m *ListProjectsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListProjectsRequest.Merge(m, src)
+}
+func (m *ListProjectsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListProjectsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListProjectsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListProjectsRequest proto.InternalMessageInfo
+
+type ListProjectsResponse struct {
+	Projects []*ProjectInfo `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+}
+
+func (m *ListProjectsResponse) Reset()      { *m = ListProjectsResponse{} }
+func (*ListProjectsResponse) ProtoMessage() {}
+func (*ListProjectsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09873f3d052f6519, []int{25}
+}
+func (m *ListProjectsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListProjectsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListProjectsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-	} else if this.AlertTitle == nil {
-		return false
-	} else if !this.AlertTitle.Equal(that1.AlertTitle) {
-		return false
+		return b[:n], nil
 	}
-	if this.Badge != that1.Badge {
-		return false
+}
+func (m *ListProjectsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListProjectsResponse.Merge(m, src)
+}
+func (m *ListProjectsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListProjectsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListProjectsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListProjectsResponse proto.InternalMessageInfo
+
+func (m *ListProjectsResponse) GetProjects() []*ProjectInfo {
+	if m != nil {
+		return m.Projects
 	}
-	if !this.Peer.Equal(that1.Peer) {
-		return false
+	return nil
+}
+
+type DeleteProjectRequest struct {
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+}
+
+func (m *DeleteProjectRequest) Reset()      { *m = DeleteProjectRequest{} }
+func (*DeleteProjectRequest) ProtoMessage() {}
+func (*DeleteProjectRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09873f3d052f6519, []int{26}
+}
+func (m *DeleteProjectRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteProjectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteProjectRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if !this.Mid.Equal(that1.Mid) {
-		return false
+}
+func (m *DeleteProjectRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteProjectRequest.Merge(m, src)
+}
+func (m *DeleteProjectRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteProjectRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteProjectRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteProjectRequest proto.InternalMessageInfo
+
+func (m *DeleteProjectRequest) GetProjectId() string {
+	if m != nil {
+		return m.ProjectId
 	}
-	if !this.Category.Equal(that1.Category) {
-		return false
+	return ""
+}
+
+type DeleteProjectResponse struct {
+}
+
+func (m *DeleteProjectResponse) Reset()      { *m = DeleteProjectResponse{} }
+func (*DeleteProjectResponse) ProtoMessage() {}
+func (*DeleteProjectResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09873f3d052f6519, []int{27}
+}
+func (m *DeleteProjectResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteProjectResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteProjectResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *AlertingPush_LocAlertBody) Equal(that interface{}) bool {
+func (m *DeleteProjectResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteProjectResponse.Merge(m, src)
+}
+func (m *DeleteProjectResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteProjectResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteProjectResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteProjectResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("main.PeerType", PeerType_name, PeerType_value)
+	proto.RegisterEnum("main.Priority", Priority_name, Priority_value)
+	proto.RegisterEnum("main.DeliveryStatus", DeliveryStatus_name, DeliveryStatus_value)
+	proto.RegisterEnum("main.ProjectSource", ProjectSource_name, ProjectSource_value)
+	proto.RegisterType((*SilentPush)(nil), "main.SilentPush")
+	proto.RegisterType((*Localizeable)(nil), "main.Localizeable")
+	proto.RegisterType((*Peer)(nil), "main.Peer")
+	proto.RegisterType((*OutPeer)(nil), "main.OutPeer")
+	proto.RegisterType((*MergeCallModel)(nil), "main.MergeCallModel")
+	proto.RegisterType((*AlertingPush)(nil), "main.AlertingPush")
+	proto.RegisterType((*VoipPush)(nil), "main.VoipPush")
+	proto.RegisterType((*EncryptedPush)(nil), "main.EncryptedPush")
+	proto.RegisterType((*ReadPush)(nil), "main.ReadPush")
+	proto.RegisterType((*PushBody)(nil), "main.PushBody")
+	proto.RegisterType((*DeviceIdList)(nil), "main.DeviceIdList")
+	proto.RegisterType((*Push)(nil), "main.Push")
+	proto.RegisterMapType((map[string]*DeviceIdList)(nil), "main.Push.DestinationsEntry")
+	proto.RegisterType((*DeviceResult)(nil), "main.DeviceResult")
+	proto.RegisterType((*DeviceResultList)(nil), "main.DeviceResultList")
+	proto.RegisterType((*TokenReplacements)(nil), "main.TokenReplacements")
+	proto.RegisterMapType((map[string]string)(nil), "main.TokenReplacements.TokensEntry")
+	proto.RegisterType((*PushError)(nil), "main.PushError")
+	proto.RegisterType((*Response)(nil), "main.Response")
+	proto.RegisterMapType((map[string]*DeviceIdList)(nil), "main.Response.ProjectInvalidationsEntry")
+	proto.RegisterMapType((map[string]*TokenReplacements)(nil), "main.Response.ProjectReplacementsEntry")
+	proto.RegisterMapType((map[string]*DeviceResultList)(nil), "main.Response.ResultsEntry")
+	proto.RegisterType((*PingRequest)(nil), "main.PingRequest")
+	proto.RegisterType((*PongResponse)(nil), "main.PongResponse")
+	proto.RegisterType((*AppleCredentials)(nil), "main.AppleCredentials")
+	proto.RegisterType((*FcmCredentials)(nil), "main.FcmCredentials")
+	proto.RegisterType((*GoogleCredentials)(nil), "main.GoogleCredentials")
+	proto.RegisterType((*ProjectSpec)(nil), "main.ProjectSpec")
+	proto.RegisterType((*ProjectInfo)(nil), "main.ProjectInfo")
+	proto.RegisterType((*ListProjectsRequest)(nil), "main.ListProjectsRequest")
+	proto.RegisterType((*ListProjectsResponse)(nil), "main.ListProjectsResponse")
+	proto.RegisterType((*DeleteProjectRequest)(nil), "main.DeleteProjectRequest")
+	proto.RegisterType((*DeleteProjectResponse)(nil), "main.DeleteProjectResponse")
+}
+
+func init() { proto.RegisterFile("push_service.proto", fileDescriptor_09873f3d052f6519) }
+
+var fileDescriptor_09873f3d052f6519 = []byte{
+	// 2227 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0xcd, 0x73, 0x1c, 0x47,
+	0x15, 0xdf, 0xd9, 0xef, 0x7d, 0xfb, 0xa1, 0x55, 0x5b, 0xb2, 0xc7, 0x6b, 0xbc, 0x38, 0x13, 0x52,
+	0x56, 0x29, 0xf6, 0x1a, 0x14, 0x52, 0x65, 0x87, 0x54, 0x11, 0xc9, 0x72, 0xac, 0x25, 0x4e, 0x90,
+	0x47, 0x76, 0x0e, 0xa1, 0xa8, 0xad, 0xd6, 0xcc, 0xf3, 0xaa, 0xd1, 0xec, 0xf4, 0x64, 0xba, 0x57,
+	0xce, 0xe6, 0xc4, 0x9d, 0x0b, 0x45, 0x51, 0xfc, 0x03, 0x5c, 0xf8, 0x03, 0xb8, 0x50, 0xc5, 0x1f,
+	0xc0, 0x81, 0x83, 0x8f, 0xa1, 0x8a, 0x03, 0x96, 0x2f, 0x1c, 0x38, 0xe4, 0xc2, 0x9d, 0xea, 0x8f,
+	0xd9, 0x9d, 0xb5, 0x24, 0x42, 0x91, 0x93, 0xba, 0x7f, 0xef, 0xf5, 0x7b, 0xbd, 0xbf, 0xf7, 0xd5,
+	0x23, 0x20, 0xc9, 0x54, 0x1c, 0x8d, 0x04, 0xa6, 0x27, 0x2c, 0xc0, 0x41, 0x92, 0x72, 0xc9, 0x49,
+	0x79, 0x42, 0x59, 0xdc, 0xeb, 0x8f, 0x39, 0x1f, 0x47, 0x78, 0x47, 0x63, 0x87, 0xd3, 0x67, 0x77,
+	0x9e, 0xa7, 0x34, 0x49, 0x30, 0x15, 0x46, 0xab, 0xb7, 0x2e, 0x02, 0x1a, 0xd1, 0xe4, 0xf0, 0x8e,
+	0xfd, 0x6b, 0x60, 0xaf, 0x05, 0x70, 0xc0, 0x22, 0x8c, 0xe5, 0xfe, 0x54, 0x1c, 0x79, 0x3b, 0xd0,
+	0x7a, 0xc4, 0x03, 0x1a, 0xb1, 0x2f, 0x91, 0x1e, 0x46, 0x48, 0xae, 0x40, 0x2d, 0xe2, 0xc1, 0xe8,
+	0x18, 0x67, 0xae, 0x73, 0xc3, 0xd9, 0x68, 0xf8, 0xd5, 0x88, 0x07, 0x1f, 0xe1, 0x8c, 0x5c, 0x85,
+	0xba, 0x12, 0xd0, 0x74, 0x2c, 0xdc, 0xe2, 0x8d, 0xd2, 0x46, 0xc3, 0x57, 0x8a, 0xdb, 0xe9, 0x58,
+	0x78, 0x8f, 0xa1, 0xbc, 0x8f, 0x98, 0x12, 0x0f, 0xca, 0x72, 0x96, 0xa0, 0x3e, 0xd8, 0xd9, 0xea,
+	0x0c, 0xd4, 0x2d, 0x07, 0x4a, 0xf2, 0x64, 0x96, 0xa0, 0xaf, 0x65, 0xa4, 0x03, 0x45, 0x16, 0xba,
+	0xc5, 0x1b, 0xce, 0x46, 0xc5, 0x2f, 0xb2, 0x90, 0xac, 0x43, 0x55, 0xc8, 0x74, 0xc4, 0x42, 0xb7,
+	0xa4, 0xdd, 0x55, 0x84, 0x4c, 0x87, 0xa1, 0x27, 0xa1, 0xf6, 0xd3, 0xa9, 0xfc, 0xbf, 0xad, 0xf6,
+	0x01, 0x68, 0x10, 0xa0, 0x10, 0x7b, 0x54, 0x1c, 0x69, 0xcb, 0x25, 0x3f, 0x87, 0xe4, 0xbc, 0x96,
+	0xf3, 0x5e, 0xef, 0x42, 0xe7, 0x63, 0x4c, 0xc7, 0x78, 0x9f, 0x46, 0xd1, 0xc7, 0x3c, 0xc4, 0x88,
+	0x74, 0xa1, 0xb4, 0xa0, 0x42, 0x2d, 0xc9, 0x1a, 0x54, 0x26, 0x4a, 0x47, 0x7b, 0xab, 0xfb, 0x66,
+	0xe3, 0xfd, 0xbe, 0x04, 0xad, 0xed, 0x08, 0x53, 0xc9, 0xe2, 0xb1, 0xe2, 0x95, 0xbc, 0x07, 0x1d,
+	0x4d, 0x97, 0xc2, 0x46, 0x87, 0x3c, 0x34, 0x36, 0x9a, 0x5b, 0xc4, 0xdc, 0x3f, 0xcf, 0xf9, 0x5e,
+	0xc1, 0x6f, 0x29, 0x2a, 0x95, 0xea, 0x0e, 0x0f, 0x67, 0xe4, 0x16, 0xac, 0x0a, 0x36, 0x49, 0x22,
+	0xcc, 0x1f, 0x57, 0xee, 0x1a, 0x7b, 0x05, 0x7f, 0xc5, 0x88, 0x16, 0xda, 0xef, 0xc3, 0xca, 0xc2,
+	0x93, 0x64, 0x32, 0x42, 0xb7, 0x74, 0xa1, 0x2b, 0xc7, 0x6f, 0x67, 0xae, 0x9e, 0x28, 0x55, 0x32,
+	0x00, 0xb2, 0xe4, 0xcb, 0x18, 0xd0, 0xac, 0xec, 0x39, 0x7e, 0x37, 0xe7, 0xcc, 0xe8, 0xaf, 0x41,
+	0xe5, 0x90, 0x86, 0x63, 0x74, 0xab, 0x9a, 0x6c, 0xb3, 0x21, 0x7d, 0x28, 0x27, 0x88, 0xa9, 0x5b,
+	0xd3, 0x8e, 0x61, 0x11, 0x23, 0x5f, 0xe3, 0x64, 0x00, 0xa5, 0x09, 0x0b, 0xdd, 0xba, 0x16, 0x7f,
+	0x67, 0x60, 0x12, 0x77, 0x90, 0x25, 0xee, 0xe0, 0x40, 0xa6, 0x2c, 0x1e, 0x7f, 0x4a, 0xa3, 0x29,
+	0xfa, 0x4a, 0x91, 0xdc, 0x85, 0x7a, 0x40, 0x25, 0x8e, 0x79, 0x3a, 0x73, 0x1b, 0xff, 0xc3, 0xa1,
+	0xb9, 0xf6, 0x4e, 0x0b, 0x60, 0x41, 0xda, 0x4e, 0x1b, 0x9a, 0xb9, 0x9f, 0xe5, 0xfd, 0xb1, 0x04,
+	0xf5, 0x4f, 0x39, 0x4b, 0x74, 0x84, 0xae, 0x40, 0x2d, 0xa0, 0x51, 0xa4, 0x92, 0xc0, 0xd1, 0x09,
+	0x52, 0x55, 0xdb, 0x61, 0x48, 0xde, 0x84, 0x36, 0x95, 0x12, 0x27, 0x89, 0x1c, 0xb1, 0x38, 0xc4,
+	0x2f, 0x6c, 0x5e, 0xb5, 0x2c, 0x38, 0x54, 0x18, 0x79, 0x03, 0x5a, 0x21, 0x13, 0x49, 0x44, 0x67,
+	0xa3, 0x98, 0x4e, 0xd0, 0x66, 0x6f, 0xd3, 0x62, 0x9f, 0xd0, 0x09, 0x92, 0x1b, 0xd0, 0xc2, 0x13,
+	0x8c, 0xe5, 0xe8, 0x70, 0x2a, 0x16, 0xa9, 0x06, 0x1a, 0xdb, 0x99, 0x8a, 0x61, 0x38, 0xa7, 0xad,
+	0x72, 0x01, 0x6d, 0xdf, 0x85, 0xe6, 0x34, 0x09, 0xa9, 0xc4, 0x91, 0xae, 0x80, 0xaa, 0x31, 0x60,
+	0x20, 0x95, 0xfd, 0xe4, 0x26, 0xac, 0x28, 0x8f, 0x5c, 0xd0, 0x68, 0x94, 0x22, 0x15, 0x3c, 0xd6,
+	0x21, 0x68, 0xf8, 0x9d, 0x0c, 0xf6, 0x35, 0x4a, 0x6e, 0x42, 0x8d, 0x9b, 0x7a, 0xb2, 0x41, 0x68,
+	0x1b, 0x67, 0xb6, 0xc8, 0xfc, 0x4c, 0xaa, 0xe2, 0x7b, 0xc2, 0x42, 0xe4, 0x9a, 0xf6, 0xba, 0x6f,
+	0x36, 0xa4, 0x0f, 0x4d, 0xcb, 0xd5, 0x48, 0xc8, 0xd4, 0x05, 0xed, 0xa3, 0x61, 0xf8, 0x3a, 0x90,
+	0xfa, 0x94, 0xe4, 0xc7, 0x18, 0xbb, 0x4d, 0x53, 0x4e, 0x7a, 0x43, 0x7a, 0x50, 0xc7, 0x38, 0x4c,
+	0x38, 0x8b, 0xa5, 0xdb, 0xd2, 0x82, 0xf9, 0x9e, 0x6c, 0x66, 0x65, 0xd4, 0xd6, 0xd7, 0x59, 0x33,
+	0xd7, 0x59, 0xae, 0xbe, 0xac, 0xb8, 0x7e, 0xe3, 0x40, 0xfb, 0x41, 0x1c, 0xa4, 0xb3, 0x44, 0x62,
+	0xa8, 0x63, 0xb7, 0x0b, 0x6b, 0xc9, 0xf4, 0x30, 0x62, 0x36, 0xed, 0x59, 0x3c, 0x1e, 0xa9, 0x36,
+	0xb9, 0x5c, 0x63, 0xf9, 0x7a, 0xf4, 0x89, 0xd1, 0xcf, 0x63, 0xe4, 0x2d, 0xe8, 0x60, 0x66, 0x76,
+	0x14, 0x52, 0x49, 0x75, 0xa4, 0x5b, 0x7e, 0x7b, 0x8e, 0xee, 0x52, 0x49, 0xd5, 0x8f, 0x8b, 0x79,
+	0x1c, 0xa0, 0xed, 0x23, 0x66, 0xe3, 0xed, 0x43, 0xdd, 0x47, 0x6a, 0xae, 0x93, 0xc5, 0xd1, 0xb9,
+	0x20, 0x8e, 0xdf, 0x83, 0x4e, 0x44, 0x85, 0x54, 0x21, 0xd2, 0x8e, 0x4c, 0xf3, 0x28, 0xf9, 0x2d,
+	0x85, 0x2a, 0x2b, 0xbb, 0x54, 0xa2, 0xf7, 0xef, 0x22, 0xd4, 0x95, 0x39, 0x5d, 0xd5, 0x6f, 0x40,
+	0x2b, 0xe0, 0x51, 0x44, 0x13, 0x81, 0xb9, 0x66, 0xdc, 0xcc, 0x30, 0xd5, 0x91, 0x6f, 0x40, 0x4b,
+	0xb2, 0x09, 0x8e, 0x24, 0x1f, 0x45, 0xec, 0x04, 0x6d, 0x9a, 0x82, 0xc2, 0x9e, 0xf0, 0x47, 0xec,
+	0x04, 0x55, 0xf7, 0x12, 0xf8, 0xb9, 0xbe, 0x77, 0xc5, 0x57, 0x4b, 0xf2, 0x0e, 0x34, 0x85, 0x6e,
+	0xfe, 0x86, 0xaf, 0xb2, 0xbe, 0x70, 0xd7, 0x5c, 0x78, 0x31, 0x15, 0xf6, 0x0a, 0x3e, 0x88, 0xf9,
+	0x8e, 0xdc, 0x83, 0xf6, 0x32, 0xcd, 0x95, 0x8b, 0x68, 0x56, 0xad, 0x8c, 0xe6, 0x29, 0xbe, 0x0d,
+	0x8d, 0x13, 0xce, 0x12, 0x73, 0xac, 0xaa, 0x8f, 0xd9, 0x0e, 0x9e, 0xd5, 0xe1, 0x5e, 0xc1, 0xaf,
+	0x9f, 0xd8, 0x35, 0x79, 0x3f, 0x1f, 0x11, 0x7d, 0xc6, 0x74, 0x94, 0x4b, 0xe6, 0xcc, 0x52, 0x12,
+	0xec, 0x15, 0x72, 0x81, 0xca, 0x9c, 0x69, 0x86, 0xf5, 0xc1, 0x7a, 0xde, 0x59, 0x16, 0x29, 0xe5,
+	0x2c, 0xb5, 0xeb, 0x9d, 0x2a, 0x94, 0x55, 0x93, 0xf0, 0x6e, 0x43, 0x6b, 0x17, 0xd5, 0x74, 0x1d,
+	0x86, 0x8f, 0x98, 0x90, 0xe4, 0x3a, 0x40, 0xa8, 0xf7, 0x23, 0x16, 0x0a, 0xd7, 0xd1, 0xb3, 0xae,
+	0x11, 0x5a, 0x0d, 0xe1, 0xfd, 0xb6, 0x08, 0x65, 0xed, 0xee, 0x03, 0x68, 0x85, 0x28, 0x24, 0x8b,
+	0xa9, 0x64, 0x3c, 0x36, 0x9a, 0xaa, 0x51, 0x99, 0xe8, 0x4f, 0xc5, 0xd1, 0x60, 0x37, 0x27, 0x7e,
+	0x10, 0xcb, 0x74, 0xe6, 0x2f, 0x9d, 0x20, 0x9e, 0xb9, 0x81, 0x5b, 0xcc, 0xdf, 0x35, 0x4b, 0x01,
+	0x5f, 0xcb, 0x54, 0x92, 0x06, 0x3c, 0x4d, 0x31, 0xd2, 0x67, 0x16, 0x83, 0xb2, 0x9d, 0x43, 0x87,
+	0x21, 0xd9, 0x84, 0x7a, 0x92, 0x32, 0x9e, 0x32, 0x39, 0x73, 0xcb, 0x4b, 0x93, 0xd2, 0xa2, 0xfe,
+	0x5c, 0xde, 0x3b, 0x80, 0xd5, 0x33, 0x37, 0x3b, 0x67, 0xd2, 0x6d, 0x40, 0xe5, 0x44, 0x75, 0x57,
+	0xb7, 0x98, 0x0f, 0x77, 0x9e, 0x2a, 0xdf, 0x28, 0xbc, 0x57, 0xbc, 0xeb, 0x78, 0x7f, 0x72, 0x32,
+	0x1a, 0x7d, 0x14, 0xd3, 0x48, 0x92, 0x6b, 0xd0, 0x98, 0xd3, 0x68, 0xcd, 0xd6, 0x33, 0x16, 0xc9,
+	0x2d, 0x35, 0x80, 0xa9, 0x9c, 0x0a, 0x6d, 0xbc, 0x93, 0xd5, 0xff, 0x2e, 0xaa, 0x4c, 0x4e, 0x67,
+	0x07, 0x5a, 0xe6, 0x5b, 0x1d, 0x15, 0x91, 0x09, 0x0a, 0x41, 0xc7, 0xb8, 0xf8, 0xfd, 0x0d, 0x8b,
+	0x0c, 0x43, 0x72, 0x19, 0xaa, 0xb6, 0xf9, 0x99, 0x16, 0x6b, 0x77, 0xaa, 0x3b, 0x4e, 0xe3, 0x14,
+	0xc7, 0x4c, 0x48, 0x4c, 0x31, 0x1c, 0x51, 0xa9, 0x33, 0xb7, 0xe4, 0x77, 0xf2, 0xf0, 0xb6, 0xf4,
+	0x3e, 0x80, 0x6e, 0xfe, 0xea, 0x3a, 0x0b, 0x6e, 0x41, 0x2d, 0xd5, 0xbb, 0x2c, 0xb0, 0x4b, 0xbf,
+	0xdf, 0x28, 0xfa, 0x99, 0x8a, 0xf7, 0x2b, 0x07, 0x56, 0x9f, 0xa8, 0xa6, 0xe7, 0x63, 0x12, 0xd1,
+	0x00, 0x27, 0x18, 0x4b, 0x41, 0x7e, 0x04, 0x55, 0xdd, 0x09, 0x33, 0x13, 0x6f, 0x1a, 0x13, 0x67,
+	0x14, 0x0d, 0x62, 0x53, 0xc4, 0x1e, 0xe9, 0xdd, 0x83, 0x66, 0x0e, 0x3e, 0xff, 0x25, 0xb2, 0x88,
+	0x4f, 0x23, 0x1f, 0x8b, 0x7b, 0xd0, 0x50, 0x59, 0xf4, 0x20, 0x4d, 0x79, 0x4a, 0x08, 0x94, 0x03,
+	0x1e, 0x9a, 0xf7, 0x53, 0xc5, 0xd7, 0x6b, 0xe2, 0x42, 0xcd, 0xd2, 0x67, 0x0f, 0x67, 0x5b, 0xef,
+	0xef, 0x65, 0xd5, 0xd7, 0x44, 0xc2, 0x63, 0x81, 0xe4, 0xe7, 0xb0, 0x9e, 0xa4, 0xfc, 0x17, 0x18,
+	0xa8, 0x49, 0x78, 0x42, 0x23, 0x16, 0x2e, 0xa5, 0xfa, 0x46, 0x56, 0x5c, 0x46, 0x7d, 0xb0, 0x6f,
+	0x74, 0x87, 0x79, 0x55, 0xf3, 0x9b, 0xd6, 0x92, 0x73, 0x44, 0xe4, 0xdd, 0x05, 0xc5, 0x45, 0x6d,
+	0xf0, 0xda, 0x6b, 0x06, 0x0d, 0xcb, 0xd6, 0x46, 0xa6, 0x4b, 0x3e, 0x83, 0xcc, 0xdc, 0x28, 0xcd,
+	0x91, 0xe8, 0x96, 0xb4, 0x8d, 0x9b, 0xe7, 0x5f, 0x2a, 0x4f, 0xb7, 0xb1, 0x77, 0x29, 0x39, 0x2b,
+	0x39, 0xa7, 0xda, 0xca, 0xe7, 0x55, 0xdb, 0x5b, 0x50, 0x41, 0x45, 0xae, 0xed, 0x84, 0x2b, 0x8b,
+	0xca, 0xd5, 0x9c, 0xfb, 0x46, 0xda, 0xfb, 0x19, 0x5c, 0xbd, 0x90, 0x93, 0x6f, 0x5b, 0x70, 0x3d,
+	0x1f, 0x5a, 0x79, 0x7e, 0xce, 0xb1, 0x77, 0x6b, 0xd9, 0xde, 0xe5, 0xb3, 0x09, 0xfc, 0xba, 0xcd,
+	0x11, 0xb8, 0x17, 0xf1, 0x75, 0x8e, 0xfd, 0xdb, 0xcb, 0xf6, 0xaf, 0x5c, 0x90, 0xdd, 0xf9, 0xcc,
+	0x6c, 0x43, 0x73, 0x9f, 0xc5, 0x63, 0x1f, 0x3f, 0x9f, 0xa2, 0x90, 0x5e, 0x07, 0x5a, 0xfb, 0x3c,
+	0x1e, 0x67, 0xc1, 0xf2, 0xbe, 0x84, 0xee, 0x76, 0x92, 0x44, 0x78, 0x3f, 0xc5, 0x10, 0x63, 0xc9,
+	0x68, 0x24, 0x94, 0xdf, 0x04, 0x27, 0xda, 0x6f, 0xcb, 0x57, 0xcb, 0xec, 0x26, 0x66, 0x58, 0xeb,
+	0x9b, 0xac, 0x43, 0xf5, 0x18, 0x67, 0xb9, 0xaf, 0x88, 0x63, 0x9c, 0x0d, 0x43, 0xf5, 0xc4, 0x93,
+	0x48, 0x27, 0x8b, 0x30, 0x56, 0xd5, 0x76, 0x18, 0xaa, 0x9a, 0x50, 0x33, 0x47, 0x87, 0xaf, 0xee,
+	0xeb, 0xb5, 0x77, 0x0f, 0x3a, 0x1f, 0x06, 0x93, 0xbc, 0xe7, 0x9b, 0xb0, 0x62, 0xbf, 0xbb, 0x46,
+	0x34, 0x08, 0xf8, 0x34, 0x96, 0xf6, 0x16, 0x1d, 0x0b, 0x6f, 0x1b, 0xd4, 0xdb, 0x82, 0xd5, 0x87,
+	0xfa, 0x75, 0x9a, 0x3f, 0x7d, 0x1d, 0x40, 0xa9, 0x61, 0x9a, 0x9b, 0xdf, 0x0d, 0x83, 0x7c, 0x84,
+	0x33, 0xef, 0xaf, 0x45, 0x68, 0x5a, 0xae, 0x0f, 0x12, 0x0c, 0x94, 0xfa, 0xbc, 0xd6, 0xb2, 0x7e,
+	0xd9, 0xc8, 0xca, 0x26, 0x54, 0x15, 0x2b, 0x68, 0x1c, 0x1e, 0xf2, 0x2f, 0xec, 0x87, 0x47, 0xb6,
+	0x55, 0x1f, 0x66, 0x31, 0x4f, 0x46, 0x13, 0x55, 0xe3, 0x25, 0x23, 0x8a, 0x79, 0xa2, 0x1e, 0x50,
+	0xea, 0x11, 0x41, 0xa3, 0x88, 0x3f, 0x37, 0xaf, 0x24, 0xa1, 0x49, 0xa8, 0xfb, 0x4d, 0x8d, 0xe9,
+	0xb1, 0x2d, 0xcc, 0xcb, 0x2d, 0x61, 0x81, 0x5b, 0xc9, 0x5e, 0x6e, 0x09, 0x0b, 0x14, 0x2a, 0xf8,
+	0x34, 0x0e, 0xed, 0x93, 0xd3, 0x6c, 0xc8, 0x00, 0x2a, 0x54, 0x45, 0xc7, 0xad, 0xe5, 0xf3, 0xe9,
+	0xf5, 0x80, 0xed, 0x15, 0x7c, 0xa3, 0x46, 0x36, 0xa0, 0xf4, 0x2c, 0x98, 0xd8, 0x49, 0x6c, 0x3b,
+	0xfc, 0x32, 0xc5, 0x7b, 0x05, 0x5f, 0xa9, 0x90, 0x1f, 0x40, 0xd5, 0x3c, 0xef, 0xdd, 0x46, 0x3e,
+	0x95, 0xce, 0x90, 0xba, 0x57, 0xf0, 0xad, 0xa2, 0x7a, 0xda, 0x07, 0x0b, 0x81, 0xf7, 0x67, 0x67,
+	0x4e, 0xe7, 0x30, 0x7e, 0xc6, 0xbf, 0x89, 0x4e, 0x02, 0xe5, 0x63, 0x16, 0x87, 0xb6, 0xfb, 0xe9,
+	0x75, 0x9e, 0xe2, 0xd2, 0xc5, 0x14, 0x97, 0x97, 0x29, 0x76, 0xa1, 0x76, 0x84, 0x34, 0x92, 0x47,
+	0x33, 0x9b, 0x4c, 0xd9, 0x96, 0xbc, 0x0d, 0x55, 0xc1, 0xa7, 0x69, 0x60, 0xde, 0xed, 0x9d, 0xec,
+	0x0d, 0x93, 0xc5, 0x5c, 0x8b, 0x7c, 0xab, 0xe2, 0xad, 0xc3, 0x25, 0x55, 0x8b, 0x56, 0x28, 0xb2,
+	0xfa, 0x78, 0x00, 0x6b, 0xcb, 0xb0, 0x6d, 0xcc, 0xb7, 0xd5, 0xb4, 0x37, 0x98, 0xed, 0xc5, 0xab,
+	0x4b, 0xd6, 0x15, 0x05, 0xfe, 0x5c, 0xc5, 0x7b, 0x17, 0xd6, 0x76, 0x31, 0x42, 0x89, 0xf3, 0xe2,
+	0xd6, 0xe6, 0xbf, 0x81, 0x24, 0xef, 0x0a, 0xac, 0xbf, 0x76, 0xcc, 0xb8, 0xdf, 0x7c, 0x1b, 0xea,
+	0xd9, 0x07, 0x38, 0x69, 0x42, 0x6d, 0x3f, 0x65, 0x27, 0x54, 0x62, 0xb7, 0x40, 0x1a, 0x50, 0x79,
+	0x98, 0xf2, 0x69, 0xd2, 0x75, 0x48, 0x0d, 0x4a, 0x07, 0xc3, 0xfd, 0x6e, 0x71, 0xf3, 0x00, 0xea,
+	0xd9, 0x1b, 0x84, 0x74, 0xa1, 0x95, 0xad, 0xb7, 0xa7, 0x92, 0x77, 0x0b, 0x64, 0x05, 0x9a, 0x19,
+	0xf2, 0x88, 0x3f, 0xef, 0x3a, 0x84, 0x40, 0x27, 0x03, 0x3e, 0xe1, 0xe9, 0x84, 0x46, 0xdd, 0x62,
+	0xfe, 0xd8, 0x1e, 0x1b, 0x1f, 0x75, 0x4b, 0x9b, 0xff, 0x72, 0xa0, 0xb3, 0xfc, 0x58, 0x20, 0xab,
+	0xd0, 0x36, 0xab, 0xa7, 0xf1, 0x71, 0xcc, 0x9f, 0xc7, 0xdd, 0x02, 0xb9, 0x04, 0x2b, 0x06, 0xb2,
+	0xaa, 0x18, 0x76, 0x1d, 0x72, 0x19, 0x88, 0x01, 0x6d, 0x4f, 0xd6, 0xfd, 0xaa, 0x5b, 0x5c, 0xe0,
+	0x4f, 0x73, 0x8f, 0x83, 0x6e, 0x89, 0xac, 0xc3, 0xaa, 0xc1, 0x7d, 0x2a, 0xf1, 0x11, 0x9b, 0x30,
+	0x89, 0x61, 0xb7, 0x4c, 0xae, 0xc2, 0xba, 0x81, 0xf7, 0xe9, 0x2c, 0xe2, 0x34, 0xf4, 0x51, 0x51,
+	0x84, 0x61, 0xb7, 0x42, 0x7a, 0x70, 0xd9, 0x88, 0x9e, 0xa4, 0x34, 0x16, 0x0c, 0x63, 0xf9, 0x21,
+	0x65, 0xd1, 0x34, 0xc5, 0x6e, 0x35, 0x67, 0x0d, 0x65, 0x3a, 0x7b, 0x3c, 0xc5, 0x29, 0x86, 0xdd,
+	0x1a, 0xb9, 0x0e, 0x57, 0xad, 0xb5, 0x94, 0xab, 0x4f, 0xae, 0xf4, 0x69, 0x4c, 0x4f, 0x28, 0x8b,
+	0xd4, 0x47, 0x7b, 0xb7, 0xbe, 0xb9, 0x05, 0xed, 0xa5, 0xbc, 0x51, 0x8c, 0x98, 0xd5, 0x7d, 0x1e,
+	0x3f, 0x63, 0x63, 0x43, 0xa4, 0x41, 0xb6, 0xc3, 0x09, 0x8b, 0xbb, 0xce, 0xd6, 0xef, 0x1c, 0xa8,
+	0xa9, 0x89, 0xc4, 0xe2, 0x31, 0xb9, 0x03, 0x65, 0xd5, 0x76, 0x49, 0x96, 0x25, 0x8b, 0x16, 0xdc,
+	0xb3, 0x53, 0x66, 0xa9, 0x0d, 0x17, 0xc8, 0x00, 0x40, 0x9d, 0x3d, 0x90, 0x29, 0xd2, 0x09, 0x81,
+	0xc5, 0x7c, 0xeb, 0x75, 0x96, 0xe7, 0xab, 0x57, 0xd8, 0x70, 0xbe, 0xef, 0x90, 0x4d, 0xf5, 0x4f,
+	0xa5, 0x78, 0x1c, 0xa1, 0xd2, 0xf9, 0xef, 0xfa, 0x5b, 0x7f, 0x73, 0xa0, 0x65, 0x7f, 0x8d, 0xbe,
+	0x2b, 0xf9, 0xa1, 0x72, 0x96, 0x25, 0x39, 0x59, 0xce, 0x64, 0xd5, 0x1b, 0x7b, 0x67, 0x93, 0xdb,
+	0x2b, 0x90, 0x87, 0xd0, 0xca, 0xd7, 0x06, 0xb9, 0x6a, 0xff, 0xdd, 0x71, 0xb6, 0x8c, 0x7a, 0xbd,
+	0xf3, 0x44, 0xf3, 0xdf, 0xfa, 0x13, 0x68, 0x2f, 0xa5, 0x39, 0xe9, 0xcd, 0x1f, 0xa3, 0x67, 0x4a,
+	0xa6, 0x77, 0xed, 0x5c, 0x59, 0x66, 0x6b, 0xe7, 0xf1, 0xe9, 0x8f, 0xd7, 0xe1, 0x12, 0x9b, 0x0c,
+	0xc2, 0x68, 0x3c, 0x50, 0xdf, 0x21, 0x03, 0x3b, 0x2a, 0x5e, 0xbc, 0xec, 0x17, 0xbe, 0x7a, 0xd9,
+	0x2f, 0x7c, 0xfd, 0xb2, 0xef, 0xfc, 0xf2, 0xb4, 0xef, 0xfc, 0xe1, 0xb4, 0xef, 0xfc, 0xe5, 0xb4,
+	0xef, 0xbc, 0x38, 0xed, 0x3b, 0xff, 0x38, 0xed, 0x3b, 0xff, 0x3c, 0xed, 0x17, 0xbe, 0x3e, 0xed,
+	0x3b, 0xbf, 0x7e, 0xd5, 0x2f, 0xbc, 0x78, 0xd5, 0x2f, 0x7c, 0xf5, 0xaa, 0x5f, 0xf8, 0xac, 0x44,
+	0x13, 0x76, 0x58, 0xd5, 0xff, 0xf1, 0x78, 0xe7, 0x3f, 0x03, 0x00, 0xb5, 0x2f, 0x14, 0x0c, 0x09,
+	0x14, 0x00, 0x00,
+}
+
+func (x PeerType) String() string {
+	s, ok := PeerType_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x Priority) String() string {
+	s, ok := Priority_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x DeliveryStatus) String() string {
+	s, ok := DeliveryStatus_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x ProjectSource) String() string {
+	s, ok := ProjectSource_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (this *SilentPush) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*AlertingPush_LocAlertBody)
+	that1, ok := that.(*SilentPush)
 	if !ok {
-		that2, ok := that.(AlertingPush_LocAlertBody)
+		that2, ok := that.(SilentPush)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1418,19 +2241,16 @@ func (this *AlertingPush_LocAlertBody) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.LocAlertBody.Equal(that1.LocAlertBody) {
-		return false
-	}
 	return true
 }
-func (this *AlertingPush_SimpleAlertBody) Equal(that interface{}) bool {
+func (this *Localizeable) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*AlertingPush_SimpleAlertBody)
+	that1, ok := that.(*Localizeable)
 	if !ok {
-		that2, ok := that.(AlertingPush_SimpleAlertBody)
+		that2, ok := that.(Localizeable)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1442,19 +2262,27 @@ func (this *AlertingPush_SimpleAlertBody) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.SimpleAlertBody != that1.SimpleAlertBody {
+	if this.LocKey != that1.LocKey {
+		return false
+	}
+	if len(this.LocArgs) != len(that1.LocArgs) {
 		return false
 	}
+	for i := range this.LocArgs {
+		if this.LocArgs[i] != that1.LocArgs[i] {
+			return false
+		}
+	}
 	return true
 }
-func (this *AlertingPush_LocAlertTitle) Equal(that interface{}) bool {
+func (this *Peer) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*AlertingPush_LocAlertTitle)
+	that1, ok := that.(*Peer)
 	if !ok {
-		that2, ok := that.(AlertingPush_LocAlertTitle)
+		that2, ok := that.(Peer)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1466,19 +2294,25 @@ func (this *AlertingPush_LocAlertTitle) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.LocAlertTitle.Equal(that1.LocAlertTitle) {
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.StrId != that1.StrId {
 		return false
 	}
 	return true
 }
-func (this *AlertingPush_SimpleAlertTitle) Equal(that interface{}) bool {
+func (this *OutPeer) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*AlertingPush_SimpleAlertTitle)
+	that1, ok := that.(*OutPeer)
 	if !ok {
-		that2, ok := that.(AlertingPush_SimpleAlertTitle)
+		that2, ok := that.(OutPeer)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1490,19 +2324,28 @@ func (this *AlertingPush_SimpleAlertTitle) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.SimpleAlertTitle != that1.SimpleAlertTitle {
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.AccessHash != that1.AccessHash {
+		return false
+	}
+	if this.StrId != that1.StrId {
 		return false
 	}
 	return true
 }
-func (this *VoipPush) Equal(that interface{}) bool {
+func (this *MergeCallModel) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*VoipPush)
+	that1, ok := that.(*MergeCallModel)
 	if !ok {
-		that2, ok := that.(VoipPush)
+		that2, ok := that.(MergeCallModel)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1514,22 +2357,196 @@ func (this *VoipPush) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.CallId != that1.CallId {
+	if this.Key != that1.Key {
 		return false
 	}
-	if this.AttemptIndex != that1.AttemptIndex {
+	if this.Merge != that1.Merge {
 		return false
 	}
-	if this.DisplayName != that1.DisplayName {
-		return false
+	return true
+}
+func (this *AlertingPush) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if this.EventBusId != that1.EventBusId {
-		return false
+
+	that1, ok := that.(*AlertingPush)
+	if !ok {
+		that2, ok := that.(AlertingPush)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if !this.Peer.Equal(that1.Peer) {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	if this.UpdateType != that1.UpdateType {
+	if that1.AlertBody == nil {
+		if this.AlertBody != nil {
+			return false
+		}
+	} else if this.AlertBody == nil {
+		return false
+	} else if !this.AlertBody.Equal(that1.AlertBody) {
+		return false
+	}
+	if that1.AlertTitle == nil {
+		if this.AlertTitle != nil {
+			return false
+		}
+	} else if this.AlertTitle == nil {
+		return false
+	} else if !this.AlertTitle.Equal(that1.AlertTitle) {
+		return false
+	}
+	if this.Badge != that1.Badge {
+		return false
+	}
+	if !this.Peer.Equal(that1.Peer) {
+		return false
+	}
+	if !this.Mid.Equal(that1.Mid) {
+		return false
+	}
+	if !this.Category.Equal(that1.Category) {
+		return false
+	}
+	return true
+}
+func (this *AlertingPush_LocAlertBody) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AlertingPush_LocAlertBody)
+	if !ok {
+		that2, ok := that.(AlertingPush_LocAlertBody)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.LocAlertBody.Equal(that1.LocAlertBody) {
+		return false
+	}
+	return true
+}
+func (this *AlertingPush_SimpleAlertBody) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AlertingPush_SimpleAlertBody)
+	if !ok {
+		that2, ok := that.(AlertingPush_SimpleAlertBody)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SimpleAlertBody != that1.SimpleAlertBody {
+		return false
+	}
+	return true
+}
+func (this *AlertingPush_LocAlertTitle) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AlertingPush_LocAlertTitle)
+	if !ok {
+		that2, ok := that.(AlertingPush_LocAlertTitle)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.LocAlertTitle.Equal(that1.LocAlertTitle) {
+		return false
+	}
+	return true
+}
+func (this *AlertingPush_SimpleAlertTitle) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AlertingPush_SimpleAlertTitle)
+	if !ok {
+		that2, ok := that.(AlertingPush_SimpleAlertTitle)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SimpleAlertTitle != that1.SimpleAlertTitle {
+		return false
+	}
+	return true
+}
+func (this *VoipPush) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VoipPush)
+	if !ok {
+		that2, ok := that.(VoipPush)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.CallId != that1.CallId {
+		return false
+	}
+	if this.AttemptIndex != that1.AttemptIndex {
+		return false
+	}
+	if this.DisplayName != that1.DisplayName {
+		return false
+	}
+	if this.EventBusId != that1.EventBusId {
+		return false
+	}
+	if !this.Peer.Equal(that1.Peer) {
+		return false
+	}
+	if this.UpdateType != that1.UpdateType {
 		return false
 	}
 	if this.DisposalReason != that1.DisposalReason {
@@ -1833,16 +2850,19 @@ func (this *Push) Equal(that interface{}) bool {
 	if this.CorrelationId != that1.CorrelationId {
 		return false
 	}
+	if this.Priority != that1.Priority {
+		return false
+	}
 	return true
 }
-func (this *Response) Equal(that interface{}) bool {
+func (this *DeviceResult) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Response)
+	that1, ok := that.(*DeviceResult)
 	if !ok {
-		that2, ok := that.(Response)
+		that2, ok := that.(DeviceResult)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1854,24 +2874,31 @@ func (this *Response) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.ProjectInvalidations) != len(that1.ProjectInvalidations) {
+	if this.DeviceId != that1.DeviceId {
 		return false
 	}
-	for i := range this.ProjectInvalidations {
-		if !this.ProjectInvalidations[i].Equal(that1.ProjectInvalidations[i]) {
-			return false
-		}
+	if this.Status != that1.Status {
+		return false
+	}
+	if this.MessageId != that1.MessageId {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	if this.UnregisteredAt != that1.UnregisteredAt {
+		return false
 	}
 	return true
 }
-func (this *PingRequest) Equal(that interface{}) bool {
+func (this *DeviceResultList) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*PingRequest)
+	that1, ok := that.(*DeviceResultList)
 	if !ok {
-		that2, ok := that.(PingRequest)
+		that2, ok := that.(DeviceResultList)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1883,16 +2910,24 @@ func (this *PingRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if len(this.Results) != len(that1.Results) {
+		return false
+	}
+	for i := range this.Results {
+		if !this.Results[i].Equal(that1.Results[i]) {
+			return false
+		}
+	}
 	return true
 }
-func (this *PongResponse) Equal(that interface{}) bool {
+func (this *TokenReplacements) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*PongResponse)
+	that1, ok := that.(*TokenReplacements)
 	if !ok {
-		that2, ok := that.(PongResponse)
+		that2, ok := that.(TokenReplacements)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1904,1310 +2939,1335 @@ func (this *PongResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if len(this.Tokens) != len(that1.Tokens) {
+		return false
+	}
+	for i := range this.Tokens {
+		if this.Tokens[i] != that1.Tokens[i] {
+			return false
+		}
+	}
 	return true
 }
-func (this *SilentPush) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *PushError) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&api.SilentPush{")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *Localizeable) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*PushError)
+	if !ok {
+		that2, ok := that.(PushError)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&api.Localizeable{")
-	s = append(s, "LocKey: "+fmt.Sprintf("%#v", this.LocKey)+",\n")
-	s = append(s, "LocArgs: "+fmt.Sprintf("%#v", this.LocArgs)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *Peer) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&api.Peer{")
-	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
-	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
-	s = append(s, "StrId: "+fmt.Sprintf("%#v", this.StrId)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *OutPeer) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Code != that1.Code {
+		return false
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&api.OutPeer{")
-	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
-	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
-	s = append(s, "AccessHash: "+fmt.Sprintf("%#v", this.AccessHash)+",\n")
-	s = append(s, "StrId: "+fmt.Sprintf("%#v", this.StrId)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	if this.Message != that1.Message {
+		return false
+	}
+	return true
 }
-func (this *MergeCallModel) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *Response) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&api.MergeCallModel{")
-	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
-	s = append(s, "Merge: "+fmt.Sprintf("%#v", this.Merge)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *AlertingPush) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*Response)
+	if !ok {
+		that2, ok := that.(Response)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 12)
-	s = append(s, "&api.AlertingPush{")
-	if this.AlertBody != nil {
-		s = append(s, "AlertBody: "+fmt.Sprintf("%#v", this.AlertBody)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if this.AlertTitle != nil {
-		s = append(s, "AlertTitle: "+fmt.Sprintf("%#v", this.AlertTitle)+",\n")
+	if len(this.ProjectInvalidations) != len(that1.ProjectInvalidations) {
+		return false
 	}
-	s = append(s, "Badge: "+fmt.Sprintf("%#v", this.Badge)+",\n")
-	if this.Peer != nil {
-		s = append(s, "Peer: "+fmt.Sprintf("%#v", this.Peer)+",\n")
+	for i := range this.ProjectInvalidations {
+		if !this.ProjectInvalidations[i].Equal(that1.ProjectInvalidations[i]) {
+			return false
+		}
 	}
-	if this.Mid != nil {
-		s = append(s, "Mid: "+fmt.Sprintf("%#v", this.Mid)+",\n")
+	if len(this.Results) != len(that1.Results) {
+		return false
 	}
-	if this.Category != nil {
-		s = append(s, "Category: "+fmt.Sprintf("%#v", this.Category)+",\n")
+	for i := range this.Results {
+		if !this.Results[i].Equal(that1.Results[i]) {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *AlertingPush_LocAlertBody) GoString() string {
-	if this == nil {
-		return "nil"
+	if len(this.ProjectReplacements) != len(that1.ProjectReplacements) {
+		return false
 	}
-	s := strings.Join([]string{`&api.AlertingPush_LocAlertBody{` +
-		`LocAlertBody:` + fmt.Sprintf("%#v", this.LocAlertBody) + `}`}, ", ")
-	return s
-}
-func (this *AlertingPush_SimpleAlertBody) GoString() string {
-	if this == nil {
-		return "nil"
+	for i := range this.ProjectReplacements {
+		if !this.ProjectReplacements[i].Equal(that1.ProjectReplacements[i]) {
+			return false
+		}
 	}
-	s := strings.Join([]string{`&api.AlertingPush_SimpleAlertBody{` +
-		`SimpleAlertBody:` + fmt.Sprintf("%#v", this.SimpleAlertBody) + `}`}, ", ")
-	return s
-}
-func (this *AlertingPush_LocAlertTitle) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.CorrelationId != that1.CorrelationId {
+		return false
 	}
-	s := strings.Join([]string{`&api.AlertingPush_LocAlertTitle{` +
-		`LocAlertTitle:` + fmt.Sprintf("%#v", this.LocAlertTitle) + `}`}, ", ")
-	return s
-}
-func (this *AlertingPush_SimpleAlertTitle) GoString() string {
-	if this == nil {
-		return "nil"
+	if !this.Error.Equal(that1.Error) {
+		return false
 	}
-	s := strings.Join([]string{`&api.AlertingPush_SimpleAlertTitle{` +
-		`SimpleAlertTitle:` + fmt.Sprintf("%#v", this.SimpleAlertTitle) + `}`}, ", ")
-	return s
+	return true
 }
-func (this *VoipPush) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 17)
-	s = append(s, "&api.VoipPush{")
-	s = append(s, "CallId: "+fmt.Sprintf("%#v", this.CallId)+",\n")
-	s = append(s, "AttemptIndex: "+fmt.Sprintf("%#v", this.AttemptIndex)+",\n")
-	s = append(s, "DisplayName: "+fmt.Sprintf("%#v", this.DisplayName)+",\n")
-	s = append(s, "EventBusId: "+fmt.Sprintf("%#v", this.EventBusId)+",\n")
-	if this.Peer != nil {
-		s = append(s, "Peer: "+fmt.Sprintf("%#v", this.Peer)+",\n")
+func (this *PingRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s = append(s, "UpdateType: "+fmt.Sprintf("%#v", this.UpdateType)+",\n")
-	s = append(s, "DisposalReason: "+fmt.Sprintf("%#v", this.DisposalReason)+",\n")
-	if this.OutPeer != nil {
-		s = append(s, "OutPeer: "+fmt.Sprintf("%#v", this.OutPeer)+",\n")
+
+	that1, ok := that.(*PingRequest)
+	if !ok {
+		that2, ok := that.(PingRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "Video: "+fmt.Sprintf("%#v", this.Video)+",\n")
-	s = append(s, "CallIdStr: "+fmt.Sprintf("%#v", this.CallIdStr)+",\n")
-	s = append(s, "Token: "+fmt.Sprintf("%#v", this.Token)+",\n")
-	s = append(s, "Endpoint: "+fmt.Sprintf("%#v", this.Endpoint)+",\n")
-	if this.Merge != nil {
-		s = append(s, "Merge: "+fmt.Sprintf("%#v", this.Merge)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *EncryptedPush) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 7)
-	s = append(s, "&api.EncryptedPush{")
-	if this.PublicAlertingPush != nil {
-		s = append(s, "PublicAlertingPush: "+fmt.Sprintf("%#v", this.PublicAlertingPush)+",\n")
+func (this *PongResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s = append(s, "EncryptedData: "+fmt.Sprintf("%#v", this.EncryptedData)+",\n")
-	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ReadPush) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*PongResponse)
+	if !ok {
+		that2, ok := that.(PongResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&api.ReadPush{")
-	if this.Peer != nil {
-		s = append(s, "Peer: "+fmt.Sprintf("%#v", this.Peer)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "LastReadDate: "+fmt.Sprintf("%#v", this.LastReadDate)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *PushBody) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *AppleCredentials) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 12)
-	s = append(s, "&api.PushBody{")
-	s = append(s, "CollapseKey: "+fmt.Sprintf("%#v", this.CollapseKey)+",\n")
-	s = append(s, "TimeToLive: "+fmt.Sprintf("%#v", this.TimeToLive)+",\n")
-	s = append(s, "Seq: "+fmt.Sprintf("%#v", this.Seq)+",\n")
-	if this.Body != nil {
-		s = append(s, "Body: "+fmt.Sprintf("%#v", this.Body)+",\n")
+
+	that1, ok := that.(*AppleCredentials)
+	if !ok {
+		that2, ok := that.(AppleCredentials)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *PushBody_SilentPush) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := strings.Join([]string{`&api.PushBody_SilentPush{` +
-		`SilentPush:` + fmt.Sprintf("%#v", this.SilentPush) + `}`}, ", ")
-	return s
-}
-func (this *PushBody_AlertingPush) GoString() string {
-	if this == nil {
-		return "nil"
+	if !bytes.Equal(this.Pem, that1.Pem) {
+		return false
 	}
-	s := strings.Join([]string{`&api.PushBody_AlertingPush{` +
-		`AlertingPush:` + fmt.Sprintf("%#v", this.AlertingPush) + `}`}, ", ")
-	return s
-}
-func (this *PushBody_VoipPush) GoString() string {
-	if this == nil {
-		return "nil"
+	if !bytes.Equal(this.Key, that1.Key) {
+		return false
 	}
-	s := strings.Join([]string{`&api.PushBody_VoipPush{` +
-		`VoipPush:` + fmt.Sprintf("%#v", this.VoipPush) + `}`}, ", ")
-	return s
-}
-func (this *PushBody_EncryptedPush) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.KeyId != that1.KeyId {
+		return false
 	}
-	s := strings.Join([]string{`&api.PushBody_EncryptedPush{` +
-		`EncryptedPush:` + fmt.Sprintf("%#v", this.EncryptedPush) + `}`}, ", ")
-	return s
-}
-func (this *PushBody_ReadPush) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.TeamId != that1.TeamId {
+		return false
 	}
-	s := strings.Join([]string{`&api.PushBody_ReadPush{` +
-		`ReadPush:` + fmt.Sprintf("%#v", this.ReadPush) + `}`}, ", ")
-	return s
-}
-func (this *DeviceIdList) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Voip != that1.Voip {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&api.DeviceIdList{")
-	s = append(s, "DeviceIds: "+fmt.Sprintf("%#v", this.DeviceIds)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *Push) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *FcmCredentials) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&api.Push{")
-	keysForDestinations := make([]string, 0, len(this.Destinations))
-	for k, _ := range this.Destinations {
-		keysForDestinations = append(keysForDestinations, k)
+
+	that1, ok := that.(*FcmCredentials)
+	if !ok {
+		that2, ok := that.(FcmCredentials)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForDestinations)
-	mapStringForDestinations := "map[string]*DeviceIdList{"
-	for _, k := range keysForDestinations {
-		mapStringForDestinations += fmt.Sprintf("%#v: %#v,", k, this.Destinations[k])
-	}
-	mapStringForDestinations += "}"
-	if this.Destinations != nil {
-		s = append(s, "Destinations: "+mapStringForDestinations+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if this.Body != nil {
-		s = append(s, "Body: "+fmt.Sprintf("%#v", this.Body)+",\n")
+	if !bytes.Equal(this.ServiceAccount, that1.ServiceAccount) {
+		return false
 	}
-	s = append(s, "CorrelationId: "+fmt.Sprintf("%#v", this.CorrelationId)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *Response) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *GoogleCredentials) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&api.Response{")
-	keysForProjectInvalidations := make([]string, 0, len(this.ProjectInvalidations))
-	for k, _ := range this.ProjectInvalidations {
-		keysForProjectInvalidations = append(keysForProjectInvalidations, k)
+
+	that1, ok := that.(*GoogleCredentials)
+	if !ok {
+		that2, ok := that.(GoogleCredentials)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForProjectInvalidations)
-	mapStringForProjectInvalidations := "map[string]*DeviceIdList{"
-	for _, k := range keysForProjectInvalidations {
-		mapStringForProjectInvalidations += fmt.Sprintf("%#v: %#v,", k, this.ProjectInvalidations[k])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	mapStringForProjectInvalidations += "}"
-	if this.ProjectInvalidations != nil {
-		s = append(s, "ProjectInvalidations: "+mapStringForProjectInvalidations+",\n")
+	if this.ServerKey != that1.ServerKey {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *PingRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *ProjectSpec) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&api.PingRequest{")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *PongResponse) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*ProjectSpec)
+	if !ok {
+		that2, ok := that.(ProjectSpec)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&api.PongResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func valueToGoStringPushService(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+	if this.ProjectId != that1.ProjectId {
+		return false
+	}
+	if this.Sandbox != that1.Sandbox {
+		return false
+	}
+	if this.NopMode != that1.NopMode {
+		return false
+	}
+	if this.AllowAlerts != that1.AllowAlerts {
+		return false
+	}
+	if this.Topic != that1.Topic {
+		return false
+	}
+	if this.Sound != that1.Sound {
+		return false
+	}
+	if that1.Credentials == nil {
+		if this.Credentials != nil {
+			return false
+		}
+	} else if this.Credentials == nil {
+		return false
+	} else if !this.Credentials.Equal(that1.Credentials) {
+		return false
+	}
+	return true
 }
+func (this *ProjectSpec_Apple) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// PushingClient is the client API for Pushing service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type PushingClient interface {
-	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PongResponse, error)
-	PushStream(ctx context.Context, opts ...grpc.CallOption) (Pushing_PushStreamClient, error)
-	SinglePush(ctx context.Context, in *Push, opts ...grpc.CallOption) (*Response, error)
+	that1, ok := that.(*ProjectSpec_Apple)
+	if !ok {
+		that2, ok := that.(ProjectSpec_Apple)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Apple.Equal(that1.Apple) {
+		return false
+	}
+	return true
 }
+func (this *ProjectSpec_Fcm) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-type pushingClient struct {
-	cc *grpc.ClientConn
+	that1, ok := that.(*ProjectSpec_Fcm)
+	if !ok {
+		that2, ok := that.(ProjectSpec_Fcm)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Fcm.Equal(that1.Fcm) {
+		return false
+	}
+	return true
 }
+func (this *ProjectSpec_Google) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func NewPushingClient(cc *grpc.ClientConn) PushingClient {
-	return &pushingClient{cc}
+	that1, ok := that.(*ProjectSpec_Google)
+	if !ok {
+		that2, ok := that.(ProjectSpec_Google)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Google.Equal(that1.Google) {
+		return false
+	}
+	return true
 }
+func (this *ProjectInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (c *pushingClient) Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PongResponse, error) {
-	out := new(PongResponse)
-	err := c.cc.Invoke(ctx, "/main.Pushing/Ping", in, out, opts...)
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*ProjectInfo)
+	if !ok {
+		that2, ok := that.(ProjectInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return out, nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ProjectId != that1.ProjectId {
+		return false
+	}
+	if this.Kind != that1.Kind {
+		return false
+	}
+	if this.Sandbox != that1.Sandbox {
+		return false
+	}
+	if this.NopMode != that1.NopMode {
+		return false
+	}
+	if this.Healthy != that1.Healthy {
+		return false
+	}
+	if this.Source != that1.Source {
+		return false
+	}
+	return true
 }
+func (this *ListProjectsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (c *pushingClient) PushStream(ctx context.Context, opts ...grpc.CallOption) (Pushing_PushStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Pushing_serviceDesc.Streams[0], "/main.Pushing/PushStream", opts...)
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*ListProjectsRequest)
+	if !ok {
+		that2, ok := that.(ListProjectsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	x := &pushingPushStreamClient{stream}
-	return x, nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
+func (this *ListProjectsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-type Pushing_PushStreamClient interface {
-	Send(*Push) error
-	Recv() (*Response, error)
-	grpc.ClientStream
+	that1, ok := that.(*ListProjectsResponse)
+	if !ok {
+		that2, ok := that.(ListProjectsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Projects) != len(that1.Projects) {
+		return false
+	}
+	for i := range this.Projects {
+		if !this.Projects[i].Equal(that1.Projects[i]) {
+			return false
+		}
+	}
+	return true
 }
+func (this *DeleteProjectRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-type pushingPushStreamClient struct {
-	grpc.ClientStream
-}
-
-func (x *pushingPushStreamClient) Send(m *Push) error {
-	return x.ClientStream.SendMsg(m)
-}
-
-func (x *pushingPushStreamClient) Recv() (*Response, error) {
-	m := new(Response)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+	that1, ok := that.(*DeleteProjectRequest)
+	if !ok {
+		that2, ok := that.(DeleteProjectRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return m, nil
-}
-
-func (c *pushingClient) SinglePush(ctx context.Context, in *Push, opts ...grpc.CallOption) (*Response, error) {
-	out := new(Response)
-	err := c.cc.Invoke(ctx, "/main.Pushing/SinglePush", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return out, nil
+	if this.ProjectId != that1.ProjectId {
+		return false
+	}
+	return true
 }
+func (this *DeleteProjectResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-// PushingServer is the server API for Pushing service.
-type PushingServer interface {
-	Ping(context.Context, *PingRequest) (*PongResponse, error)
-	PushStream(Pushing_PushStreamServer) error
-	SinglePush(context.Context, *Push) (*Response, error)
+	that1, ok := that.(*DeleteProjectResponse)
+	if !ok {
+		that2, ok := that.(DeleteProjectResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
-
-// UnimplementedPushingServer can be embedded to have forward compatible implementations.
-type UnimplementedPushingServer struct {
+func (this *SilentPush) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&api.SilentPush{")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (*UnimplementedPushingServer) Ping(ctx context.Context, req *PingRequest) (*PongResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+func (this *Localizeable) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&api.Localizeable{")
+	s = append(s, "LocKey: "+fmt.Sprintf("%#v", this.LocKey)+",\n")
+	s = append(s, "LocArgs: "+fmt.Sprintf("%#v", this.LocArgs)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (*UnimplementedPushingServer) PushStream(srv Pushing_PushStreamServer) error {
-	return status.Errorf(codes.Unimplemented, "method PushStream not implemented")
+func (this *Peer) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&api.Peer{")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "StrId: "+fmt.Sprintf("%#v", this.StrId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (*UnimplementedPushingServer) SinglePush(ctx context.Context, req *Push) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SinglePush not implemented")
+func (this *OutPeer) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&api.OutPeer{")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "AccessHash: "+fmt.Sprintf("%#v", this.AccessHash)+",\n")
+	s = append(s, "StrId: "+fmt.Sprintf("%#v", this.StrId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func RegisterPushingServer(s *grpc.Server, srv PushingServer) {
-	s.RegisterService(&_Pushing_serviceDesc, srv)
+func (this *MergeCallModel) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&api.MergeCallModel{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "Merge: "+fmt.Sprintf("%#v", this.Merge)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func _Pushing_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PingRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (this *AlertingPush) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if interceptor == nil {
-		return srv.(PushingServer).Ping(ctx, in)
+	s := make([]string, 0, 12)
+	s = append(s, "&api.AlertingPush{")
+	if this.AlertBody != nil {
+		s = append(s, "AlertBody: "+fmt.Sprintf("%#v", this.AlertBody)+",\n")
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/main.Pushing/Ping",
+	if this.AlertTitle != nil {
+		s = append(s, "AlertTitle: "+fmt.Sprintf("%#v", this.AlertTitle)+",\n")
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PushingServer).Ping(ctx, req.(*PingRequest))
+	s = append(s, "Badge: "+fmt.Sprintf("%#v", this.Badge)+",\n")
+	if this.Peer != nil {
+		s = append(s, "Peer: "+fmt.Sprintf("%#v", this.Peer)+",\n")
 	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _Pushing_PushStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(PushingServer).PushStream(&pushingPushStreamServer{stream})
+	if this.Mid != nil {
+		s = append(s, "Mid: "+fmt.Sprintf("%#v", this.Mid)+",\n")
+	}
+	if this.Category != nil {
+		s = append(s, "Category: "+fmt.Sprintf("%#v", this.Category)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-type Pushing_PushStreamServer interface {
-	Send(*Response) error
-	Recv() (*Push, error)
-	grpc.ServerStream
+func (this *AlertingPush_LocAlertBody) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&api.AlertingPush_LocAlertBody{` +
+		`LocAlertBody:` + fmt.Sprintf("%#v", this.LocAlertBody) + `}`}, ", ")
+	return s
 }
-
-type pushingPushStreamServer struct {
-	grpc.ServerStream
+func (this *AlertingPush_SimpleAlertBody) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&api.AlertingPush_SimpleAlertBody{` +
+		`SimpleAlertBody:` + fmt.Sprintf("%#v", this.SimpleAlertBody) + `}`}, ", ")
+	return s
 }
-
-func (x *pushingPushStreamServer) Send(m *Response) error {
-	return x.ServerStream.SendMsg(m)
+func (this *AlertingPush_LocAlertTitle) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&api.AlertingPush_LocAlertTitle{` +
+		`LocAlertTitle:` + fmt.Sprintf("%#v", this.LocAlertTitle) + `}`}, ", ")
+	return s
 }
-
-func (x *pushingPushStreamServer) Recv() (*Push, error) {
-	m := new(Push)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
+func (this *AlertingPush_SimpleAlertTitle) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return m, nil
+	s := strings.Join([]string{`&api.AlertingPush_SimpleAlertTitle{` +
+		`SimpleAlertTitle:` + fmt.Sprintf("%#v", this.SimpleAlertTitle) + `}`}, ", ")
+	return s
 }
-
-func _Pushing_SinglePush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Push)
-	if err := dec(in); err != nil {
-		return nil, err
+func (this *VoipPush) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if interceptor == nil {
-		return srv.(PushingServer).SinglePush(ctx, in)
+	s := make([]string, 0, 17)
+	s = append(s, "&api.VoipPush{")
+	s = append(s, "CallId: "+fmt.Sprintf("%#v", this.CallId)+",\n")
+	s = append(s, "AttemptIndex: "+fmt.Sprintf("%#v", this.AttemptIndex)+",\n")
+	s = append(s, "DisplayName: "+fmt.Sprintf("%#v", this.DisplayName)+",\n")
+	s = append(s, "EventBusId: "+fmt.Sprintf("%#v", this.EventBusId)+",\n")
+	if this.Peer != nil {
+		s = append(s, "Peer: "+fmt.Sprintf("%#v", this.Peer)+",\n")
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/main.Pushing/SinglePush",
+	s = append(s, "UpdateType: "+fmt.Sprintf("%#v", this.UpdateType)+",\n")
+	s = append(s, "DisposalReason: "+fmt.Sprintf("%#v", this.DisposalReason)+",\n")
+	if this.OutPeer != nil {
+		s = append(s, "OutPeer: "+fmt.Sprintf("%#v", this.OutPeer)+",\n")
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PushingServer).SinglePush(ctx, req.(*Push))
+	s = append(s, "Video: "+fmt.Sprintf("%#v", this.Video)+",\n")
+	s = append(s, "CallIdStr: "+fmt.Sprintf("%#v", this.CallIdStr)+",\n")
+	s = append(s, "Token: "+fmt.Sprintf("%#v", this.Token)+",\n")
+	s = append(s, "Endpoint: "+fmt.Sprintf("%#v", this.Endpoint)+",\n")
+	if this.Merge != nil {
+		s = append(s, "Merge: "+fmt.Sprintf("%#v", this.Merge)+",\n")
 	}
-	return interceptor(ctx, in, info, handler)
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-var _Pushing_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "main.Pushing",
-	HandlerType: (*PushingServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Ping",
-			Handler:    _Pushing_Ping_Handler,
-		},
-		{
-			MethodName: "SinglePush",
-			Handler:    _Pushing_SinglePush_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "PushStream",
-			Handler:       _Pushing_PushStream_Handler,
-			ServerStreams: true,
-			ClientStreams: true,
-		},
-	},
-	Metadata: "push_service.proto",
-}
-
-func (m *SilentPush) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *EncryptedPush) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 7)
+	s = append(s, "&api.EncryptedPush{")
+	if this.PublicAlertingPush != nil {
+		s = append(s, "PublicAlertingPush: "+fmt.Sprintf("%#v", this.PublicAlertingPush)+",\n")
+	}
+	s = append(s, "EncryptedData: "+fmt.Sprintf("%#v", this.EncryptedData)+",\n")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *SilentPush) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *ReadPush) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&api.ReadPush{")
+	if this.Peer != nil {
+		s = append(s, "Peer: "+fmt.Sprintf("%#v", this.Peer)+",\n")
+	}
+	s = append(s, "LastReadDate: "+fmt.Sprintf("%#v", this.LastReadDate)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *SilentPush) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (this *PushBody) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 12)
+	s = append(s, "&api.PushBody{")
+	s = append(s, "CollapseKey: "+fmt.Sprintf("%#v", this.CollapseKey)+",\n")
+	s = append(s, "TimeToLive: "+fmt.Sprintf("%#v", this.TimeToLive)+",\n")
+	s = append(s, "Seq: "+fmt.Sprintf("%#v", this.Seq)+",\n")
+	if this.Body != nil {
+		s = append(s, "Body: "+fmt.Sprintf("%#v", this.Body)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *Localizeable) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *PushBody_SilentPush) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := strings.Join([]string{`&api.PushBody_SilentPush{` +
+		`SilentPush:` + fmt.Sprintf("%#v", this.SilentPush) + `}`}, ", ")
+	return s
 }
-
-func (m *Localizeable) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *PushBody_AlertingPush) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&api.PushBody_AlertingPush{` +
+		`AlertingPush:` + fmt.Sprintf("%#v", this.AlertingPush) + `}`}, ", ")
+	return s
 }
-
-func (m *Localizeable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.LocArgs) > 0 {
-		for iNdEx := len(m.LocArgs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.LocArgs[iNdEx])
-			copy(dAtA[i:], m.LocArgs[iNdEx])
-			i = encodeVarintPushService(dAtA, i, uint64(len(m.LocArgs[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+func (this *PushBody_VoipPush) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.LocKey) > 0 {
-		i -= len(m.LocKey)
-		copy(dAtA[i:], m.LocKey)
-		i = encodeVarintPushService(dAtA, i, uint64(len(m.LocKey)))
-		i--
-		dAtA[i] = 0xa
+	s := strings.Join([]string{`&api.PushBody_VoipPush{` +
+		`VoipPush:` + fmt.Sprintf("%#v", this.VoipPush) + `}`}, ", ")
+	return s
+}
+func (this *PushBody_EncryptedPush) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return len(dAtA) - i, nil
+	s := strings.Join([]string{`&api.PushBody_EncryptedPush{` +
+		`EncryptedPush:` + fmt.Sprintf("%#v", this.EncryptedPush) + `}`}, ", ")
+	return s
 }
-
-func (m *Peer) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *PushBody_ReadPush) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := strings.Join([]string{`&api.PushBody_ReadPush{` +
+		`ReadPush:` + fmt.Sprintf("%#v", this.ReadPush) + `}`}, ", ")
+	return s
 }
-
-func (m *Peer) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *DeviceIdList) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&api.DeviceIdList{")
+	s = append(s, "DeviceIds: "+fmt.Sprintf("%#v", this.DeviceIds)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *Peer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.StrId) > 0 {
-		i -= len(m.StrId)
-		copy(dAtA[i:], m.StrId)
-		i = encodeVarintPushService(dAtA, i, uint64(len(m.StrId)))
-		i--
-		dAtA[i] = 0x1a
+func (this *Push) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.Id != 0 {
-		i = encodeVarintPushService(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
+	s := make([]string, 0, 8)
+	s = append(s, "&api.Push{")
+	keysForDestinations := make([]string, 0, len(this.Destinations))
+	for k, _ := range this.Destinations {
+		keysForDestinations = append(keysForDestinations, k)
 	}
-	if m.Type != 0 {
-		i = encodeVarintPushService(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x8
+	github_com_gogo_protobuf_sortkeys.Strings(keysForDestinations)
+	mapStringForDestinations := "map[string]*DeviceIdList{"
+	for _, k := range keysForDestinations {
+		mapStringForDestinations += fmt.Sprintf("%#v: %#v,", k, this.Destinations[k])
 	}
-	return len(dAtA) - i, nil
+	mapStringForDestinations += "}"
+	if this.Destinations != nil {
+		s = append(s, "Destinations: "+mapStringForDestinations+",\n")
+	}
+	if this.Body != nil {
+		s = append(s, "Body: "+fmt.Sprintf("%#v", this.Body)+",\n")
+	}
+	s = append(s, "CorrelationId: "+fmt.Sprintf("%#v", this.CorrelationId)+",\n")
+	s = append(s, "Priority: "+fmt.Sprintf("%#v", this.Priority)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *OutPeer) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *DeviceResult) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 9)
+	s = append(s, "&api.DeviceResult{")
+	s = append(s, "DeviceId: "+fmt.Sprintf("%#v", this.DeviceId)+",\n")
+	s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
+	s = append(s, "MessageId: "+fmt.Sprintf("%#v", this.MessageId)+",\n")
+	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
+	s = append(s, "UnregisteredAt: "+fmt.Sprintf("%#v", this.UnregisteredAt)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *OutPeer) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *DeviceResultList) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&api.DeviceResultList{")
+	if this.Results != nil {
+		s = append(s, "Results: "+fmt.Sprintf("%#v", this.Results)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *OutPeer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.StrId) > 0 {
-		i -= len(m.StrId)
-		copy(dAtA[i:], m.StrId)
-		i = encodeVarintPushService(dAtA, i, uint64(len(m.StrId)))
-		i--
-		dAtA[i] = 0x22
+func (this *TokenReplacements) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.AccessHash != 0 {
-		i = encodeVarintPushService(dAtA, i, uint64(m.AccessHash))
-		i--
-		dAtA[i] = 0x18
+	s := make([]string, 0, 5)
+	s = append(s, "&api.TokenReplacements{")
+	keysForTokens := make([]string, 0, len(this.Tokens))
+	for k, _ := range this.Tokens {
+		keysForTokens = append(keysForTokens, k)
 	}
-	if m.Id != 0 {
-		i = encodeVarintPushService(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
+	github_com_gogo_protobuf_sortkeys.Strings(keysForTokens)
+	mapStringForTokens := "map[string]string{"
+	for _, k := range keysForTokens {
+		mapStringForTokens += fmt.Sprintf("%#v: %#v,", k, this.Tokens[k])
 	}
-	if m.Type != 0 {
-		i = encodeVarintPushService(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x8
+	mapStringForTokens += "}"
+	if this.Tokens != nil {
+		s = append(s, "Tokens: "+mapStringForTokens+",\n")
 	}
-	return len(dAtA) - i, nil
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *MergeCallModel) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *PushError) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 6)
+	s = append(s, "&api.PushError{")
+	s = append(s, "Code: "+fmt.Sprintf("%#v", this.Code)+",\n")
+	s = append(s, "Message: "+fmt.Sprintf("%#v", this.Message)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *MergeCallModel) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MergeCallModel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Merge {
-		i--
-		if m.Merge {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
+func (this *Response) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintPushService(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+	s := make([]string, 0, 9)
+	s = append(s, "&api.Response{")
+	keysForProjectInvalidations := make([]string, 0, len(this.ProjectInvalidations))
+	for k, _ := range this.ProjectInvalidations {
+		keysForProjectInvalidations = append(keysForProjectInvalidations, k)
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *AlertingPush) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	github_com_gogo_protobuf_sortkeys.Strings(keysForProjectInvalidations)
+	mapStringForProjectInvalidations := "map[string]*DeviceIdList{"
+	for _, k := range keysForProjectInvalidations {
+		mapStringForProjectInvalidations += fmt.Sprintf("%#v: %#v,", k, this.ProjectInvalidations[k])
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AlertingPush) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *AlertingPush) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Category != nil {
-		{
-			size, err := m.Category.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPushService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x4a
+	mapStringForProjectInvalidations += "}"
+	if this.ProjectInvalidations != nil {
+		s = append(s, "ProjectInvalidations: "+mapStringForProjectInvalidations+",\n")
 	}
-	if m.Mid != nil {
-		{
-			size, err := m.Mid.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPushService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
+	keysForResults := make([]string, 0, len(this.Results))
+	for k, _ := range this.Results {
+		keysForResults = append(keysForResults, k)
 	}
-	if m.Peer != nil {
-		{
-			size, err := m.Peer.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPushService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
+	github_com_gogo_protobuf_sortkeys.Strings(keysForResults)
+	mapStringForResults := "map[string]*DeviceResultList{"
+	for _, k := range keysForResults {
+		mapStringForResults += fmt.Sprintf("%#v: %#v,", k, this.Results[k])
 	}
-	if m.Badge != 0 {
-		i = encodeVarintPushService(dAtA, i, uint64(m.Badge))
-		i--
-		dAtA[i] = 0x30
+	mapStringForResults += "}"
+	if this.Results != nil {
+		s = append(s, "Results: "+mapStringForResults+",\n")
 	}
-	if m.AlertTitle != nil {
-		{
-			size := m.AlertTitle.Size()
-			i -= size
-			if _, err := m.AlertTitle.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
+	keysForProjectReplacements := make([]string, 0, len(this.ProjectReplacements))
+	for k, _ := range this.ProjectReplacements {
+		keysForProjectReplacements = append(keysForProjectReplacements, k)
 	}
-	if m.AlertBody != nil {
-		{
-			size := m.AlertBody.Size()
-			i -= size
-			if _, err := m.AlertBody.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForProjectReplacements)
+	mapStringForProjectReplacements := "map[string]*TokenReplacements{"
+	for _, k := range keysForProjectReplacements {
+		mapStringForProjectReplacements += fmt.Sprintf("%#v: %#v,", k, this.ProjectReplacements[k])
 	}
-	return len(dAtA) - i, nil
+	mapStringForProjectReplacements += "}"
+	if this.ProjectReplacements != nil {
+		s = append(s, "ProjectReplacements: "+mapStringForProjectReplacements+",\n")
+	}
+	s = append(s, "CorrelationId: "+fmt.Sprintf("%#v", this.CorrelationId)+",\n")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *AlertingPush_LocAlertBody) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *PingRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&api.PingRequest{")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *AlertingPush_LocAlertBody) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.LocAlertBody != nil {
-		{
-			size, err := m.LocAlertBody.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPushService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (this *PongResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return len(dAtA) - i, nil
+	s := make([]string, 0, 4)
+	s = append(s, "&api.PongResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (m *AlertingPush_SimpleAlertBody) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *AppleCredentials) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&api.AppleCredentials{")
+	s = append(s, "Pem: "+fmt.Sprintf("%#v", this.Pem)+",\n")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "KeyId: "+fmt.Sprintf("%#v", this.KeyId)+",\n")
+	s = append(s, "TeamId: "+fmt.Sprintf("%#v", this.TeamId)+",\n")
+	s = append(s, "Voip: "+fmt.Sprintf("%#v", this.Voip)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *AlertingPush_SimpleAlertBody) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= len(m.SimpleAlertBody)
-	copy(dAtA[i:], m.SimpleAlertBody)
-	i = encodeVarintPushService(dAtA, i, uint64(len(m.SimpleAlertBody)))
-	i--
-	dAtA[i] = 0x12
-	return len(dAtA) - i, nil
+func (this *FcmCredentials) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&api.FcmCredentials{")
+	s = append(s, "ServiceAccount: "+fmt.Sprintf("%#v", this.ServiceAccount)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (m *AlertingPush_LocAlertTitle) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *GoogleCredentials) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&api.GoogleCredentials{")
+	s = append(s, "ServerKey: "+fmt.Sprintf("%#v", this.ServerKey)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *AlertingPush_LocAlertTitle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.LocAlertTitle != nil {
-		{
-			size, err := m.LocAlertTitle.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPushService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+func (this *ProjectSpec) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return len(dAtA) - i, nil
+	s := make([]string, 0, 13)
+	s = append(s, "&api.ProjectSpec{")
+	s = append(s, "ProjectId: "+fmt.Sprintf("%#v", this.ProjectId)+",\n")
+	s = append(s, "Sandbox: "+fmt.Sprintf("%#v", this.Sandbox)+",\n")
+	s = append(s, "NopMode: "+fmt.Sprintf("%#v", this.NopMode)+",\n")
+	s = append(s, "AllowAlerts: "+fmt.Sprintf("%#v", this.AllowAlerts)+",\n")
+	s = append(s, "Topic: "+fmt.Sprintf("%#v", this.Topic)+",\n")
+	s = append(s, "Sound: "+fmt.Sprintf("%#v", this.Sound)+",\n")
+	if this.Credentials != nil {
+		s = append(s, "Credentials: "+fmt.Sprintf("%#v", this.Credentials)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (m *AlertingPush_SimpleAlertTitle) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *ProjectSpec_Apple) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&api.ProjectSpec_Apple{` +
+		`Apple:` + fmt.Sprintf("%#v", this.Apple) + `}`}, ", ")
+	return s
 }
-
-func (m *AlertingPush_SimpleAlertTitle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= len(m.SimpleAlertTitle)
-	copy(dAtA[i:], m.SimpleAlertTitle)
-	i = encodeVarintPushService(dAtA, i, uint64(len(m.SimpleAlertTitle)))
-	i--
-	dAtA[i] = 0x22
-	return len(dAtA) - i, nil
+func (this *ProjectSpec_Fcm) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&api.ProjectSpec_Fcm{` +
+		`Fcm:` + fmt.Sprintf("%#v", this.Fcm) + `}`}, ", ")
+	return s
 }
-func (m *VoipPush) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *ProjectSpec_Google) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := strings.Join([]string{`&api.ProjectSpec_Google{` +
+		`Google:` + fmt.Sprintf("%#v", this.Google) + `}`}, ", ")
+	return s
 }
-
-func (m *VoipPush) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *ProjectInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&api.ProjectInfo{")
+	s = append(s, "ProjectId: "+fmt.Sprintf("%#v", this.ProjectId)+",\n")
+	s = append(s, "Kind: "+fmt.Sprintf("%#v", this.Kind)+",\n")
+	s = append(s, "Sandbox: "+fmt.Sprintf("%#v", this.Sandbox)+",\n")
+	s = append(s, "NopMode: "+fmt.Sprintf("%#v", this.NopMode)+",\n")
+	s = append(s, "Healthy: "+fmt.Sprintf("%#v", this.Healthy)+",\n")
+	s = append(s, "Source: "+fmt.Sprintf("%#v", this.Source)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *VoipPush) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Merge != nil {
-		{
-			size, err := m.Merge.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPushService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6a
-	}
-	if len(m.Endpoint) > 0 {
-		i -= len(m.Endpoint)
-		copy(dAtA[i:], m.Endpoint)
-		i = encodeVarintPushService(dAtA, i, uint64(len(m.Endpoint)))
-		i--
-		dAtA[i] = 0x62
-	}
-	if len(m.Token) > 0 {
-		i -= len(m.Token)
-		copy(dAtA[i:], m.Token)
-		i = encodeVarintPushService(dAtA, i, uint64(len(m.Token)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if len(m.CallIdStr) > 0 {
-		i -= len(m.CallIdStr)
-		copy(dAtA[i:], m.CallIdStr)
-		i = encodeVarintPushService(dAtA, i, uint64(len(m.CallIdStr)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if m.Video {
-		i--
-		if m.Video {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.OutPeer != nil {
-		{
-			size, err := m.OutPeer.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPushService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.DisposalReason) > 0 {
-		i -= len(m.DisposalReason)
-		copy(dAtA[i:], m.DisposalReason)
-		i = encodeVarintPushService(dAtA, i, uint64(len(m.DisposalReason)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.UpdateType) > 0 {
-		i -= len(m.UpdateType)
-		copy(dAtA[i:], m.UpdateType)
-		i = encodeVarintPushService(dAtA, i, uint64(len(m.UpdateType)))
-		i--
-		dAtA[i] = 0x32
+func (this *ListProjectsRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.Peer != nil {
-		{
-			size, err := m.Peer.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPushService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
+	s := make([]string, 0, 4)
+	s = append(s, "&api.ListProjectsRequest{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ListProjectsResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.EventBusId) > 0 {
-		i -= len(m.EventBusId)
-		copy(dAtA[i:], m.EventBusId)
-		i = encodeVarintPushService(dAtA, i, uint64(len(m.EventBusId)))
-		i--
-		dAtA[i] = 0x22
+	s := make([]string, 0, 5)
+	s = append(s, "&api.ListProjectsResponse{")
+	if this.Projects != nil {
+		s = append(s, "Projects: "+fmt.Sprintf("%#v", this.Projects)+",\n")
 	}
-	if len(m.DisplayName) > 0 {
-		i -= len(m.DisplayName)
-		copy(dAtA[i:], m.DisplayName)
-		i = encodeVarintPushService(dAtA, i, uint64(len(m.DisplayName)))
-		i--
-		dAtA[i] = 0x1a
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DeleteProjectRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.AttemptIndex != 0 {
-		i = encodeVarintPushService(dAtA, i, uint64(m.AttemptIndex))
-		i--
-		dAtA[i] = 0x10
+	s := make([]string, 0, 5)
+	s = append(s, "&api.DeleteProjectRequest{")
+	s = append(s, "ProjectId: "+fmt.Sprintf("%#v", this.ProjectId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DeleteProjectResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.CallId != 0 {
-		i = encodeVarintPushService(dAtA, i, uint64(m.CallId))
-		i--
-		dAtA[i] = 0x8
+	s := make([]string, 0, 4)
+	s = append(s, "&api.DeleteProjectResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringPushService(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
 	}
-	return len(dAtA) - i, nil
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
 }
 
-func (m *EncryptedPush) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// PushingClient is the client API for Pushing service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type PushingClient interface {
+	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PongResponse, error)
+	PushStream(ctx context.Context, opts ...grpc.CallOption) (Pushing_PushStreamClient, error)
+	SinglePush(ctx context.Context, in *Push, opts ...grpc.CallOption) (*Response, error)
 }
 
-func (m *EncryptedPush) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type pushingClient struct {
+	cc *grpc.ClientConn
 }
 
-func (m *EncryptedPush) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		i = encodeVarintPushService(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.EncryptedData) > 0 {
-		i -= len(m.EncryptedData)
-		copy(dAtA[i:], m.EncryptedData)
-		i = encodeVarintPushService(dAtA, i, uint64(len(m.EncryptedData)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.PublicAlertingPush != nil {
-		{
-			size, err := m.PublicAlertingPush.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPushService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func NewPushingClient(cc *grpc.ClientConn) PushingClient {
+	return &pushingClient{cc}
+}
+
+func (c *pushingClient) Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PongResponse, error) {
+	out := new(PongResponse)
+	err := c.cc.Invoke(ctx, "/main.Pushing/Ping", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *ReadPush) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *pushingClient) PushStream(ctx context.Context, opts ...grpc.CallOption) (Pushing_PushStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Pushing_serviceDesc.Streams[0], "/main.Pushing/PushStream", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	x := &pushingPushStreamClient{stream}
+	return x, nil
 }
 
-func (m *ReadPush) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Pushing_PushStreamClient interface {
+	Send(*Push) error
+	Recv() (*Response, error)
+	grpc.ClientStream
 }
 
-func (m *ReadPush) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LastReadDate != 0 {
-		i = encodeVarintPushService(dAtA, i, uint64(m.LastReadDate))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Peer != nil {
-		{
-			size, err := m.Peer.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPushService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+type pushingPushStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *pushingPushStreamClient) Send(m *Push) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *pushingPushStreamClient) Recv() (*Response, error) {
+	m := new(Response)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return m, nil
 }
 
-func (m *PushBody) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *pushingClient) SinglePush(ctx context.Context, in *Push, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/main.Pushing/SinglePush", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *PushBody) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// PushingServer is the server API for Pushing service.
+type PushingServer interface {
+	Ping(context.Context, *PingRequest) (*PongResponse, error)
+	PushStream(Pushing_PushStreamServer) error
+	SinglePush(context.Context, *Push) (*Response, error)
 }
 
-func (m *PushBody) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Body != nil {
-		{
-			size := m.Body.Size()
-			i -= size
-			if _, err := m.Body.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
+// UnimplementedPushingServer can be embedded to have forward compatible implementations.
+type UnimplementedPushingServer struct {
+}
+
+func (*UnimplementedPushingServer) Ping(ctx context.Context, req *PingRequest) (*PongResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+}
+func (*UnimplementedPushingServer) PushStream(srv Pushing_PushStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method PushStream not implemented")
+}
+func (*UnimplementedPushingServer) SinglePush(ctx context.Context, req *Push) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SinglePush not implemented")
+}
+
+func RegisterPushingServer(s *grpc.Server, srv PushingServer) {
+	s.RegisterService(&_Pushing_serviceDesc, srv)
+}
+
+func _Pushing_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Seq != 0 {
-		i = encodeVarintPushService(dAtA, i, uint64(m.Seq))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(PushingServer).Ping(ctx, in)
 	}
-	if m.TimeToLive != 0 {
-		i = encodeVarintPushService(dAtA, i, uint64(m.TimeToLive))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/main.Pushing/Ping",
 	}
-	if len(m.CollapseKey) > 0 {
-		i -= len(m.CollapseKey)
-		copy(dAtA[i:], m.CollapseKey)
-		i = encodeVarintPushService(dAtA, i, uint64(len(m.CollapseKey)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushingServer).Ping(ctx, req.(*PingRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PushBody_SilentPush) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Pushing_PushStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PushingServer).PushStream(&pushingPushStreamServer{stream})
 }
 
-func (m *PushBody_SilentPush) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.SilentPush != nil {
-		{
-			size, err := m.SilentPush.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPushService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	return len(dAtA) - i, nil
-}
-func (m *PushBody_AlertingPush) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Pushing_PushStreamServer interface {
+	Send(*Response) error
+	Recv() (*Push, error)
+	grpc.ServerStream
 }
 
-func (m *PushBody_AlertingPush) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.AlertingPush != nil {
-		{
-			size, err := m.AlertingPush.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPushService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	return len(dAtA) - i, nil
+type pushingPushStreamServer struct {
+	grpc.ServerStream
 }
-func (m *PushBody_VoipPush) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func (x *pushingPushStreamServer) Send(m *Response) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (m *PushBody_VoipPush) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.VoipPush != nil {
-		{
-			size, err := m.VoipPush.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPushService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
+func (x *pushingPushStreamServer) Recv() (*Push, error) {
+	m := new(Push)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return m, nil
 }
-func (m *PushBody_EncryptedPush) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func _Pushing_SinglePush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Push)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushingServer).SinglePush(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/main.Pushing/SinglePush",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushingServer).SinglePush(ctx, req.(*Push))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PushBody_EncryptedPush) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.EncryptedPush != nil {
+var _Pushing_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "main.Pushing",
+	HandlerType: (*PushingServer)(nil),
+	Methods: []grpc.MethodDesc{
 		{
-			size, err := m.EncryptedPush.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPushService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	return len(dAtA) - i, nil
+			MethodName: "Ping",
+			Handler:    _Pushing_Ping_Handler,
+		},
+		{
+			MethodName: "SinglePush",
+			Handler:    _Pushing_SinglePush_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "PushStream",
+			Handler:       _Pushing_PushStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "push_service.proto",
 }
-func (m *PushBody_ReadPush) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+// ProjectAdminClient is the client API for ProjectAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ProjectAdminClient interface {
+	// PutProject creates or replaces the project
+	PutProject(ctx context.Context, in *ProjectSpec, opts ...grpc.CallOption) (*ProjectInfo, error)
+	ListProjects(ctx context.Context, in *ListProjectsRequest, opts ...grpc.CallOption) (*ListProjectsResponse, error)
+	DeleteProject(ctx context.Context, in *DeleteProjectRequest, opts ...grpc.CallOption) (*DeleteProjectResponse, error)
 }
 
-func (m *PushBody_ReadPush) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.ReadPush != nil {
-		{
-			size, err := m.ReadPush.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPushService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
-	}
-	return len(dAtA) - i, nil
+type projectAdminClient struct {
+	cc *grpc.ClientConn
 }
-func (m *DeviceIdList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+
+func NewProjectAdminClient(cc *grpc.ClientConn) ProjectAdminClient {
+	return &projectAdminClient{cc}
+}
+
+func (c *projectAdminClient) PutProject(ctx context.Context, in *ProjectSpec, opts ...grpc.CallOption) (*ProjectInfo, error) {
+	out := new(ProjectInfo)
+	err := c.cc.Invoke(ctx, "/main.ProjectAdmin/PutProject", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DeviceIdList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *DeviceIdList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DeviceIds) > 0 {
-		for iNdEx := len(m.DeviceIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.DeviceIds[iNdEx])
-			copy(dAtA[i:], m.DeviceIds[iNdEx])
-			i = encodeVarintPushService(dAtA, i, uint64(len(m.DeviceIds[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *projectAdminClient) ListProjects(ctx context.Context, in *ListProjectsRequest, opts ...grpc.CallOption) (*ListProjectsResponse, error) {
+	out := new(ListProjectsResponse)
+	err := c.cc.Invoke(ctx, "/main.ProjectAdmin/ListProjects", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *Push) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *projectAdminClient) DeleteProject(ctx context.Context, in *DeleteProjectRequest, opts ...grpc.CallOption) (*DeleteProjectResponse, error) {
+	out := new(DeleteProjectResponse)
+	err := c.cc.Invoke(ctx, "/main.ProjectAdmin/DeleteProject", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *Push) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ProjectAdminServer is the server API for ProjectAdmin service.
+type ProjectAdminServer interface {
+	// PutProject creates or replaces the project
+	PutProject(context.Context, *ProjectSpec) (*ProjectInfo, error)
+	ListProjects(context.Context, *ListProjectsRequest) (*ListProjectsResponse, error)
+	DeleteProject(context.Context, *DeleteProjectRequest) (*DeleteProjectResponse, error)
 }
 
-func (m *Push) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CorrelationId) > 0 {
-		i -= len(m.CorrelationId)
-		copy(dAtA[i:], m.CorrelationId)
-		i = encodeVarintPushService(dAtA, i, uint64(len(m.CorrelationId)))
-		i--
-		dAtA[i] = 0x1a
+// UnimplementedProjectAdminServer can be embedded to have forward compatible implementations.
+type UnimplementedProjectAdminServer struct {
+}
+
+func (*UnimplementedProjectAdminServer) PutProject(ctx context.Context, req *ProjectSpec) (*ProjectInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutProject not implemented")
+}
+func (*UnimplementedProjectAdminServer) ListProjects(ctx context.Context, req *ListProjectsRequest) (*ListProjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProjects not implemented")
+}
+func (*UnimplementedProjectAdminServer) DeleteProject(ctx context.Context, req *DeleteProjectRequest) (*DeleteProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProject not implemented")
+}
+
+func RegisterProjectAdminServer(s *grpc.Server, srv ProjectAdminServer) {
+	s.RegisterService(&_ProjectAdmin_serviceDesc, srv)
+}
+
+func _ProjectAdmin_PutProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectSpec)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Body != nil {
-		{
-			size, err := m.Body.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPushService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(ProjectAdminServer).PutProject(ctx, in)
 	}
-	if len(m.Destinations) > 0 {
-		for k := range m.Destinations {
-			v := m.Destinations[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintPushService(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintPushService(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintPushService(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/main.ProjectAdmin/PutProject",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectAdminServer).PutProject(ctx, req.(*ProjectSpec))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Response) Marshal() (dAtA []byte, err error) {
+func _ProjectAdmin_ListProjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectAdminServer).ListProjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/main.ProjectAdmin/ListProjects",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectAdminServer).ListProjects(ctx, req.(*ListProjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectAdmin_DeleteProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectAdminServer).DeleteProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/main.ProjectAdmin/DeleteProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectAdminServer).DeleteProject(ctx, req.(*DeleteProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ProjectAdmin_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "main.ProjectAdmin",
+	HandlerType: (*ProjectAdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PutProject",
+			Handler:    _ProjectAdmin_PutProject_Handler,
+		},
+		{
+			MethodName: "ListProjects",
+			Handler:    _ProjectAdmin_ListProjects_Handler,
+		},
+		{
+			MethodName: "DeleteProject",
+			Handler:    _ProjectAdmin_DeleteProject_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "push_service.proto",
+}
+
+func (m *SilentPush) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3217,46 +4277,20 @@ func (m *Response) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Response) MarshalTo(dAtA []byte) (int, error) {
+func (m *SilentPush) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SilentPush) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ProjectInvalidations) > 0 {
-		for k := range m.ProjectInvalidations {
-			v := m.ProjectInvalidations[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintPushService(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintPushService(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintPushService(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
-		}
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PingRequest) Marshal() (dAtA []byte, err error) {
+func (m *Localizeable) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3266,20 +4300,36 @@ func (m *PingRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PingRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *Localizeable) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Localizeable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.LocArgs) > 0 {
+		for iNdEx := len(m.LocArgs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LocArgs[iNdEx])
+			copy(dAtA[i:], m.LocArgs[iNdEx])
+			i = encodeVarintPushService(dAtA, i, uint64(len(m.LocArgs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.LocKey) > 0 {
+		i -= len(m.LocKey)
+		copy(dAtA[i:], m.LocKey)
+		i = encodeVarintPushService(dAtA, i, uint64(len(m.LocKey)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PongResponse) Marshal() (dAtA []byte, err error) {
+func (m *Peer) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3289,754 +4339,4731 @@ func (m *PongResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PongResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *Peer) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PongResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Peer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.StrId) > 0 {
+		i -= len(m.StrId)
+		copy(dAtA[i:], m.StrId)
+		i = encodeVarintPushService(dAtA, i, uint64(len(m.StrId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintPushService(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintPushService(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintPushService(dAtA []byte, offset int, v uint64) int {
-	offset -= sovPushService(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *SilentPush) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OutPeer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Localizeable) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.LocKey)
-	if l > 0 {
-		n += 1 + l + sovPushService(uint64(l))
-	}
-	if len(m.LocArgs) > 0 {
-		for _, s := range m.LocArgs {
-			l = len(s)
-			n += 1 + l + sovPushService(uint64(l))
-		}
-	}
-	return n
+func (m *OutPeer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Peer) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *OutPeer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovPushService(uint64(m.Type))
+	if len(m.StrId) > 0 {
+		i -= len(m.StrId)
+		copy(dAtA[i:], m.StrId)
+		i = encodeVarintPushService(dAtA, i, uint64(len(m.StrId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.AccessHash != 0 {
+		i = encodeVarintPushService(dAtA, i, uint64(m.AccessHash))
+		i--
+		dAtA[i] = 0x18
 	}
 	if m.Id != 0 {
-		n += 1 + sovPushService(uint64(m.Id))
+		i = encodeVarintPushService(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.StrId)
-	if l > 0 {
-		n += 1 + l + sovPushService(uint64(l))
+	if m.Type != 0 {
+		i = encodeVarintPushService(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *OutPeer) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Type != 0 {
-		n += 1 + sovPushService(uint64(m.Type))
-	}
-	if m.Id != 0 {
-		n += 1 + sovPushService(uint64(m.Id))
-	}
-	if m.AccessHash != 0 {
-		n += 1 + sovPushService(uint64(m.AccessHash))
-	}
-	l = len(m.StrId)
-	if l > 0 {
-		n += 1 + l + sovPushService(uint64(l))
+func (m *MergeCallModel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MergeCallModel) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MergeCallModel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MergeCallModel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovPushService(uint64(l))
-	}
 	if m.Merge {
-		n += 2
+		i--
+		if m.Merge {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintPushService(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *AlertingPush) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AlertingPush) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AlertingPush) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlertingPush) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.AlertBody != nil {
-		n += m.AlertBody.Size()
-	}
-	if m.AlertTitle != nil {
-		n += m.AlertTitle.Size()
+	if m.Category != nil {
+		{
+			size, err := m.Category.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPushService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
 	}
-	if m.Badge != 0 {
-		n += 1 + sovPushService(uint64(m.Badge))
+	if m.Mid != nil {
+		{
+			size, err := m.Mid.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPushService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
 	if m.Peer != nil {
-		l = m.Peer.Size()
-		n += 1 + l + sovPushService(uint64(l))
+		{
+			size, err := m.Peer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPushService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.Mid != nil {
-		l = m.Mid.Size()
-		n += 1 + l + sovPushService(uint64(l))
+	if m.Badge != 0 {
+		i = encodeVarintPushService(dAtA, i, uint64(m.Badge))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.Category != nil {
-		l = m.Category.Size()
-		n += 1 + l + sovPushService(uint64(l))
+	if m.AlertTitle != nil {
+		{
+			size := m.AlertTitle.Size()
+			i -= size
+			if _, err := m.AlertTitle.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
 	}
-	return n
+	if m.AlertBody != nil {
+		{
+			size := m.AlertBody.Size()
+			i -= size
+			if _, err := m.AlertBody.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *AlertingPush_LocAlertBody) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *AlertingPush_LocAlertBody) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlertingPush_LocAlertBody) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	if m.LocAlertBody != nil {
-		l = m.LocAlertBody.Size()
-		n += 1 + l + sovPushService(uint64(l))
+		{
+			size, err := m.LocAlertBody.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPushService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *AlertingPush_SimpleAlertBody) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.SimpleAlertBody)
-	n += 1 + l + sovPushService(uint64(l))
-	return n
+func (m *AlertingPush_SimpleAlertBody) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *AlertingPush_LocAlertTitle) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+
+func (m *AlertingPush_SimpleAlertBody) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.SimpleAlertBody)
+	copy(dAtA[i:], m.SimpleAlertBody)
+	i = encodeVarintPushService(dAtA, i, uint64(len(m.SimpleAlertBody)))
+	i--
+	dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+func (m *AlertingPush_LocAlertTitle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlertingPush_LocAlertTitle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	if m.LocAlertTitle != nil {
-		l = m.LocAlertTitle.Size()
-		n += 1 + l + sovPushService(uint64(l))
+		{
+			size, err := m.LocAlertTitle.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPushService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *AlertingPush_SimpleAlertTitle) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AlertingPush_SimpleAlertTitle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlertingPush_SimpleAlertTitle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.SimpleAlertTitle)
+	copy(dAtA[i:], m.SimpleAlertTitle)
+	i = encodeVarintPushService(dAtA, i, uint64(len(m.SimpleAlertTitle)))
+	i--
+	dAtA[i] = 0x22
+	return len(dAtA) - i, nil
+}
+func (m *VoipPush) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *VoipPush) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoipPush) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.SimpleAlertTitle)
-	n += 1 + l + sovPushService(uint64(l))
-	return n
-}
-func (m *VoipPush) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CallId != 0 {
-		n += 1 + sovPushService(uint64(m.CallId))
+	if m.Merge != nil {
+		{
+			size, err := m.Merge.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPushService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
 	}
-	if m.AttemptIndex != 0 {
-		n += 1 + sovPushService(uint64(m.AttemptIndex))
+	if len(m.Endpoint) > 0 {
+		i -= len(m.Endpoint)
+		copy(dAtA[i:], m.Endpoint)
+		i = encodeVarintPushService(dAtA, i, uint64(len(m.Endpoint)))
+		i--
+		dAtA[i] = 0x62
 	}
-	l = len(m.DisplayName)
-	if l > 0 {
-		n += 1 + l + sovPushService(uint64(l))
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintPushService(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x5a
 	}
-	l = len(m.EventBusId)
-	if l > 0 {
-		n += 1 + l + sovPushService(uint64(l))
+	if len(m.CallIdStr) > 0 {
+		i -= len(m.CallIdStr)
+		copy(dAtA[i:], m.CallIdStr)
+		i = encodeVarintPushService(dAtA, i, uint64(len(m.CallIdStr)))
+		i--
+		dAtA[i] = 0x52
 	}
-	if m.Peer != nil {
-		l = m.Peer.Size()
-		n += 1 + l + sovPushService(uint64(l))
+	if m.Video {
+		i--
+		if m.Video {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
 	}
-	l = len(m.UpdateType)
-	if l > 0 {
-		n += 1 + l + sovPushService(uint64(l))
+	if m.OutPeer != nil {
+		{
+			size, err := m.OutPeer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPushService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
-	l = len(m.DisposalReason)
-	if l > 0 {
-		n += 1 + l + sovPushService(uint64(l))
+	if len(m.DisposalReason) > 0 {
+		i -= len(m.DisposalReason)
+		copy(dAtA[i:], m.DisposalReason)
+		i = encodeVarintPushService(dAtA, i, uint64(len(m.DisposalReason)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.OutPeer != nil {
-		l = m.OutPeer.Size()
-		n += 1 + l + sovPushService(uint64(l))
+	if len(m.UpdateType) > 0 {
+		i -= len(m.UpdateType)
+		copy(dAtA[i:], m.UpdateType)
+		i = encodeVarintPushService(dAtA, i, uint64(len(m.UpdateType)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.Video {
-		n += 2
+	if m.Peer != nil {
+		{
+			size, err := m.Peer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPushService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.CallIdStr)
-	if l > 0 {
-		n += 1 + l + sovPushService(uint64(l))
+	if len(m.EventBusId) > 0 {
+		i -= len(m.EventBusId)
+		copy(dAtA[i:], m.EventBusId)
+		i = encodeVarintPushService(dAtA, i, uint64(len(m.EventBusId)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Token)
-	if l > 0 {
-		n += 1 + l + sovPushService(uint64(l))
+	if len(m.DisplayName) > 0 {
+		i -= len(m.DisplayName)
+		copy(dAtA[i:], m.DisplayName)
+		i = encodeVarintPushService(dAtA, i, uint64(len(m.DisplayName)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Endpoint)
-	if l > 0 {
-		n += 1 + l + sovPushService(uint64(l))
+	if m.AttemptIndex != 0 {
+		i = encodeVarintPushService(dAtA, i, uint64(m.AttemptIndex))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Merge != nil {
-		l = m.Merge.Size()
-		n += 1 + l + sovPushService(uint64(l))
+	if m.CallId != 0 {
+		i = encodeVarintPushService(dAtA, i, uint64(m.CallId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EncryptedPush) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EncryptedPush) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EncryptedPush) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EncryptedPush) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PublicAlertingPush != nil {
-		l = m.PublicAlertingPush.Size()
-		n += 1 + l + sovPushService(uint64(l))
+	if m.Nonce != 0 {
+		i = encodeVarintPushService(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.EncryptedData)
-	if l > 0 {
-		n += 1 + l + sovPushService(uint64(l))
+	if len(m.EncryptedData) > 0 {
+		i -= len(m.EncryptedData)
+		copy(dAtA[i:], m.EncryptedData)
+		i = encodeVarintPushService(dAtA, i, uint64(len(m.EncryptedData)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Nonce != 0 {
-		n += 1 + sovPushService(uint64(m.Nonce))
+	if m.PublicAlertingPush != nil {
+		{
+			size, err := m.PublicAlertingPush.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPushService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ReadPush) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ReadPush) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadPush) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReadPush) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Peer != nil {
-		l = m.Peer.Size()
-		n += 1 + l + sovPushService(uint64(l))
-	}
 	if m.LastReadDate != 0 {
-		n += 1 + sovPushService(uint64(m.LastReadDate))
+		i = encodeVarintPushService(dAtA, i, uint64(m.LastReadDate))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.Peer != nil {
+		{
+			size, err := m.Peer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPushService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *PushBody) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PushBody) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PushBody) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PushBody) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.CollapseKey)
-	if l > 0 {
-		n += 1 + l + sovPushService(uint64(l))
-	}
-	if m.TimeToLive != 0 {
-		n += 1 + sovPushService(uint64(m.TimeToLive))
+	if m.Body != nil {
+		{
+			size := m.Body.Size()
+			i -= size
+			if _, err := m.Body.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
 	}
 	if m.Seq != 0 {
-		n += 1 + sovPushService(uint64(m.Seq))
+		i = encodeVarintPushService(dAtA, i, uint64(m.Seq))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Body != nil {
-		n += m.Body.Size()
+	if m.TimeToLive != 0 {
+		i = encodeVarintPushService(dAtA, i, uint64(m.TimeToLive))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.CollapseKey) > 0 {
+		i -= len(m.CollapseKey)
+		copy(dAtA[i:], m.CollapseKey)
+		i = encodeVarintPushService(dAtA, i, uint64(len(m.CollapseKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *PushBody_SilentPush) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *PushBody_SilentPush) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PushBody_SilentPush) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	if m.SilentPush != nil {
-		l = m.SilentPush.Size()
-		n += 1 + l + sovPushService(uint64(l))
+		{
+			size, err := m.SilentPush.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPushService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *PushBody_AlertingPush) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *PushBody_AlertingPush) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PushBody_AlertingPush) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	if m.AlertingPush != nil {
-		l = m.AlertingPush.Size()
-		n += 1 + l + sovPushService(uint64(l))
+		{
+			size, err := m.AlertingPush.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPushService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *PushBody_VoipPush) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.VoipPush != nil {
-		l = m.VoipPush.Size()
-		n += 1 + l + sovPushService(uint64(l))
-	}
-	return n
+func (m *PushBody_VoipPush) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *PushBody_EncryptedPush) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.EncryptedPush != nil {
-		l = m.EncryptedPush.Size()
-		n += 1 + l + sovPushService(uint64(l))
+
+func (m *PushBody_VoipPush) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.VoipPush != nil {
+		{
+			size, err := m.VoipPush.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPushService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *PushBody_ReadPush) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ReadPush != nil {
-		l = m.ReadPush.Size()
-		n += 1 + l + sovPushService(uint64(l))
-	}
-	return n
+func (m *PushBody_EncryptedPush) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *DeviceIdList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.DeviceIds) > 0 {
-		for _, s := range m.DeviceIds {
-			l = len(s)
-			n += 1 + l + sovPushService(uint64(l))
+
+func (m *PushBody_EncryptedPush) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.EncryptedPush != nil {
+		{
+			size, err := m.EncryptedPush.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPushService(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *PushBody_ReadPush) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Push) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Destinations) > 0 {
-		for k, v := range m.Destinations {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovPushService(uint64(l))
+func (m *PushBody_ReadPush) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ReadPush != nil {
+		{
+			size, err := m.ReadPush.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			mapEntrySize := 1 + len(k) + sovPushService(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovPushService(uint64(mapEntrySize))
+			i -= size
+			i = encodeVarintPushService(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.Body != nil {
-		l = m.Body.Size()
-		n += 1 + l + sovPushService(uint64(l))
-	}
-	l = len(m.CorrelationId)
-	if l > 0 {
-		n += 1 + l + sovPushService(uint64(l))
+	return len(dAtA) - i, nil
+}
+func (m *DeviceIdList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Response) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *DeviceIdList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeviceIdList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ProjectInvalidations) > 0 {
-		for k, v := range m.ProjectInvalidations {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovPushService(uint64(l))
-			}
-			mapEntrySize := 1 + len(k) + sovPushService(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovPushService(uint64(mapEntrySize))
+	if len(m.DeviceIds) > 0 {
+		for iNdEx := len(m.DeviceIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeviceIds[iNdEx])
+			copy(dAtA[i:], m.DeviceIds[iNdEx])
+			i = encodeVarintPushService(dAtA, i, uint64(len(m.DeviceIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PingRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Push) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PongResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Push) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Push) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if m.Priority != 0 {
+		i = encodeVarintPushService(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.CorrelationId) > 0 {
+		i -= len(m.CorrelationId)
+		copy(dAtA[i:], m.CorrelationId)
+		i = encodeVarintPushService(dAtA, i, uint64(len(m.CorrelationId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Body != nil {
+		{
+			size, err := m.Body.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPushService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Destinations) > 0 {
+		for k := range m.Destinations {
+			v := m.Destinations[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintPushService(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintPushService(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintPushService(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovPushService(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *DeviceResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozPushService(x uint64) (n int) {
-	return sovPushService(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *DeviceResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *SilentPush) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *DeviceResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnregisteredAt != 0 {
+		i = encodeVarintPushService(dAtA, i, uint64(m.UnregisteredAt))
+		i--
+		dAtA[i] = 0x28
 	}
-	s := strings.Join([]string{`&SilentPush{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Localizeable) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintPushService(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
 	}
-	s := strings.Join([]string{`&Localizeable{`,
-		`LocKey:` + fmt.Sprintf("%v", this.LocKey) + `,`,
-		`LocArgs:` + fmt.Sprintf("%v", this.LocArgs) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Peer) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.MessageId) > 0 {
+		i -= len(m.MessageId)
+		copy(dAtA[i:], m.MessageId)
+		i = encodeVarintPushService(dAtA, i, uint64(len(m.MessageId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	s := strings.Join([]string{`&Peer{`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
-		`StrId:` + fmt.Sprintf("%v", this.StrId) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *OutPeer) String() string {
-	if this == nil {
-		return "nil"
+	if m.Status != 0 {
+		i = encodeVarintPushService(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
 	}
-	s := strings.Join([]string{`&OutPeer{`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
-		`AccessHash:` + fmt.Sprintf("%v", this.AccessHash) + `,`,
-		`StrId:` + fmt.Sprintf("%v", this.StrId) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MergeCallModel) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.DeviceId) > 0 {
+		i -= len(m.DeviceId)
+		copy(dAtA[i:], m.DeviceId)
+		i = encodeVarintPushService(dAtA, i, uint64(len(m.DeviceId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	s := strings.Join([]string{`&MergeCallModel{`,
-		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
-		`Merge:` + fmt.Sprintf("%v", this.Merge) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *AlertingPush) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *DeviceResultList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&AlertingPush{`,
-		`AlertBody:` + fmt.Sprintf("%v", this.AlertBody) + `,`,
-		`AlertTitle:` + fmt.Sprintf("%v", this.AlertTitle) + `,`,
-		`Badge:` + fmt.Sprintf("%v", this.Badge) + `,`,
-		`Peer:` + strings.Replace(this.Peer.String(), "Peer", "Peer", 1) + `,`,
-		`Mid:` + strings.Replace(fmt.Sprintf("%v", this.Mid), "StringValue", "types.StringValue", 1) + `,`,
-		`Category:` + strings.Replace(fmt.Sprintf("%v", this.Category), "StringValue", "types.StringValue", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *AlertingPush_LocAlertBody) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *DeviceResultList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeviceResultList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPushService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	s := strings.Join([]string{`&AlertingPush_LocAlertBody{`,
-		`LocAlertBody:` + strings.Replace(fmt.Sprintf("%v", this.LocAlertBody), "Localizeable", "Localizeable", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *AlertingPush_SimpleAlertBody) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *TokenReplacements) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&AlertingPush_SimpleAlertBody{`,
-		`SimpleAlertBody:` + fmt.Sprintf("%v", this.SimpleAlertBody) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *AlertingPush_LocAlertTitle) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *TokenReplacements) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenReplacements) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
+		for k := range m.Tokens {
+			v := m.Tokens[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintPushService(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintPushService(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintPushService(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	s := strings.Join([]string{`&AlertingPush_LocAlertTitle{`,
-		`LocAlertTitle:` + strings.Replace(fmt.Sprintf("%v", this.LocAlertTitle), "Localizeable", "Localizeable", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *AlertingPush_SimpleAlertTitle) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PushError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&AlertingPush_SimpleAlertTitle{`,
-		`SimpleAlertTitle:` + fmt.Sprintf("%v", this.SimpleAlertTitle) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *VoipPush) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PushError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PushError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintPushService(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x12
 	}
-	s := strings.Join([]string{`&VoipPush{`,
-		`CallId:` + fmt.Sprintf("%v", this.CallId) + `,`,
-		`AttemptIndex:` + fmt.Sprintf("%v", this.AttemptIndex) + `,`,
-		`DisplayName:` + fmt.Sprintf("%v", this.DisplayName) + `,`,
-		`EventBusId:` + fmt.Sprintf("%v", this.EventBusId) + `,`,
-		`Peer:` + strings.Replace(this.Peer.String(), "Peer", "Peer", 1) + `,`,
-		`UpdateType:` + fmt.Sprintf("%v", this.UpdateType) + `,`,
-		`DisposalReason:` + fmt.Sprintf("%v", this.DisposalReason) + `,`,
-		`OutPeer:` + strings.Replace(this.OutPeer.String(), "OutPeer", "OutPeer", 1) + `,`,
-		`Video:` + fmt.Sprintf("%v", this.Video) + `,`,
-		`CallIdStr:` + fmt.Sprintf("%v", this.CallIdStr) + `,`,
-		`Token:` + fmt.Sprintf("%v", this.Token) + `,`,
-		`Endpoint:` + fmt.Sprintf("%v", this.Endpoint) + `,`,
-		`Merge:` + strings.Replace(this.Merge.String(), "MergeCallModel", "MergeCallModel", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *EncryptedPush) String() string {
-	if this == nil {
-		return "nil"
+	if m.Code != 0 {
+		i = encodeVarintPushService(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
 	}
-	s := strings.Join([]string{`&EncryptedPush{`,
-		`PublicAlertingPush:` + strings.Replace(this.PublicAlertingPush.String(), "AlertingPush", "AlertingPush", 1) + `,`,
-		`EncryptedData:` + fmt.Sprintf("%v", this.EncryptedData) + `,`,
-		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *ReadPush) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&ReadPush{`,
-		`Peer:` + strings.Replace(this.Peer.String(), "Peer", "Peer", 1) + `,`,
-		`LastReadDate:` + fmt.Sprintf("%v", this.LastReadDate) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *PushBody) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&PushBody{`,
-		`CollapseKey:` + fmt.Sprintf("%v", this.CollapseKey) + `,`,
-		`TimeToLive:` + fmt.Sprintf("%v", this.TimeToLive) + `,`,
-		`Seq:` + fmt.Sprintf("%v", this.Seq) + `,`,
-		`Body:` + fmt.Sprintf("%v", this.Body) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *PushBody_SilentPush) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPushService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	s := strings.Join([]string{`&PushBody_SilentPush{`,
-		`SilentPush:` + strings.Replace(fmt.Sprintf("%v", this.SilentPush), "SilentPush", "SilentPush", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PushBody_AlertingPush) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.CorrelationId) > 0 {
+		i -= len(m.CorrelationId)
+		copy(dAtA[i:], m.CorrelationId)
+		i = encodeVarintPushService(dAtA, i, uint64(len(m.CorrelationId)))
+		i--
+		dAtA[i] = 0x22
 	}
-	s := strings.Join([]string{`&PushBody_AlertingPush{`,
-		`AlertingPush:` + strings.Replace(fmt.Sprintf("%v", this.AlertingPush), "AlertingPush", "AlertingPush", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PushBody_VoipPush) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.ProjectReplacements) > 0 {
+		for k := range m.ProjectReplacements {
+			v := m.ProjectReplacements[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintPushService(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintPushService(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintPushService(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	s := strings.Join([]string{`&PushBody_VoipPush{`,
-		`VoipPush:` + strings.Replace(fmt.Sprintf("%v", this.VoipPush), "VoipPush", "VoipPush", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PushBody_EncryptedPush) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Results) > 0 {
+		for k := range m.Results {
+			v := m.Results[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintPushService(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintPushService(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintPushService(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	s := strings.Join([]string{`&PushBody_EncryptedPush{`,
-		`EncryptedPush:` + strings.Replace(fmt.Sprintf("%v", this.EncryptedPush), "EncryptedPush", "EncryptedPush", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PushBody_ReadPush) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.ProjectInvalidations) > 0 {
+		for k := range m.ProjectInvalidations {
+			v := m.ProjectInvalidations[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintPushService(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintPushService(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintPushService(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	s := strings.Join([]string{`&PushBody_ReadPush{`,
-		`ReadPush:` + strings.Replace(fmt.Sprintf("%v", this.ReadPush), "ReadPush", "ReadPush", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *DeviceIdList) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&DeviceIdList{`,
-		`DeviceIds:` + fmt.Sprintf("%v", this.DeviceIds) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *Push) String() string {
-	if this == nil {
-		return "nil"
-	}
-	keysForDestinations := make([]string, 0, len(this.Destinations))
-	for k, _ := range this.Destinations {
-		keysForDestinations = append(keysForDestinations, k)
-	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForDestinations)
-	mapStringForDestinations := "map[string]*DeviceIdList{"
-	for _, k := range keysForDestinations {
-		mapStringForDestinations += fmt.Sprintf("%v: %v,", k, this.Destinations[k])
-	}
-	mapStringForDestinations += "}"
-	s := strings.Join([]string{`&Push{`,
-		`Destinations:` + mapStringForDestinations + `,`,
-		`Body:` + strings.Replace(this.Body.String(), "PushBody", "PushBody", 1) + `,`,
-		`CorrelationId:` + fmt.Sprintf("%v", this.CorrelationId) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *PingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *Response) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *PongResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	keysForProjectInvalidations := make([]string, 0, len(this.ProjectInvalidations))
-	for k, _ := range this.ProjectInvalidations {
-		keysForProjectInvalidations = append(keysForProjectInvalidations, k)
+	return dAtA[:n], nil
+}
+
+func (m *PongResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PongResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *AppleCredentials) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForProjectInvalidations)
-	mapStringForProjectInvalidations := "map[string]*DeviceIdList{"
-	for _, k := range keysForProjectInvalidations {
-		mapStringForProjectInvalidations += fmt.Sprintf("%v: %v,", k, this.ProjectInvalidations[k])
+	return dAtA[:n], nil
+}
+
+func (m *AppleCredentials) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AppleCredentials) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Voip {
+		i--
+		if m.Voip {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
-	mapStringForProjectInvalidations += "}"
-	s := strings.Join([]string{`&Response{`,
-		`ProjectInvalidations:` + mapStringForProjectInvalidations + `,`,
-		`}`,
-	}, "")
-	return s
+	if len(m.TeamId) > 0 {
+		i -= len(m.TeamId)
+		copy(dAtA[i:], m.TeamId)
+		i = encodeVarintPushService(dAtA, i, uint64(len(m.TeamId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.KeyId) > 0 {
+		i -= len(m.KeyId)
+		copy(dAtA[i:], m.KeyId)
+		i = encodeVarintPushService(dAtA, i, uint64(len(m.KeyId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintPushService(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pem) > 0 {
+		i -= len(m.Pem)
+		copy(dAtA[i:], m.Pem)
+		i = encodeVarintPushService(dAtA, i, uint64(len(m.Pem)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
-func (this *PingRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *FcmCredentials) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&PingRequest{`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *PongResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *FcmCredentials) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FcmCredentials) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ServiceAccount) > 0 {
+		i -= len(m.ServiceAccount)
+		copy(dAtA[i:], m.ServiceAccount)
+		i = encodeVarintPushService(dAtA, i, uint64(len(m.ServiceAccount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GoogleCredentials) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GoogleCredentials) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GoogleCredentials) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ServerKey) > 0 {
+		i -= len(m.ServerKey)
+		copy(dAtA[i:], m.ServerKey)
+		i = encodeVarintPushService(dAtA, i, uint64(len(m.ServerKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProjectSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Credentials != nil {
+		{
+			size := m.Credentials.Size()
+			i -= size
+			if _, err := m.Credentials.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if len(m.Sound) > 0 {
+		i -= len(m.Sound)
+		copy(dAtA[i:], m.Sound)
+		i = encodeVarintPushService(dAtA, i, uint64(len(m.Sound)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Topic) > 0 {
+		i -= len(m.Topic)
+		copy(dAtA[i:], m.Topic)
+		i = encodeVarintPushService(dAtA, i, uint64(len(m.Topic)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.AllowAlerts {
+		i--
+		if m.AllowAlerts {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NopMode {
+		i--
+		if m.NopMode {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Sandbox {
+		i--
+		if m.Sandbox {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ProjectId) > 0 {
+		i -= len(m.ProjectId)
+		copy(dAtA[i:], m.ProjectId)
+		i = encodeVarintPushService(dAtA, i, uint64(len(m.ProjectId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProjectSpec_Apple) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectSpec_Apple) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Apple != nil {
+		{
+			size, err := m.Apple.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPushService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ProjectSpec_Fcm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectSpec_Fcm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Fcm != nil {
+		{
+			size, err := m.Fcm.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPushService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ProjectSpec_Google) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectSpec_Google) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Google != nil {
+		{
+			size, err := m.Google.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPushService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ProjectInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Source != 0 {
+		i = encodeVarintPushService(dAtA, i, uint64(m.Source))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Healthy {
+		i--
+		if m.Healthy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.NopMode {
+		i--
+		if m.NopMode {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Sandbox {
+		i--
+		if m.Sandbox {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintPushService(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProjectId) > 0 {
+		i -= len(m.ProjectId)
+		copy(dAtA[i:], m.ProjectId)
+		i = encodeVarintPushService(dAtA, i, uint64(len(m.ProjectId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListProjectsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListProjectsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListProjectsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ListProjectsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListProjectsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListProjectsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Projects) > 0 {
+		for iNdEx := len(m.Projects) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Projects[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPushService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteProjectRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteProjectRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteProjectRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProjectId) > 0 {
+		i -= len(m.ProjectId)
+		copy(dAtA[i:], m.ProjectId)
+		i = encodeVarintPushService(dAtA, i, uint64(len(m.ProjectId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteProjectResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteProjectResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteProjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPushService(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPushService(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SilentPush) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *Localizeable) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LocKey)
+	if l > 0 {
+		n += 1 + l + sovPushService(uint64(l))
+	}
+	if len(m.LocArgs) > 0 {
+		for _, s := range m.LocArgs {
+			l = len(s)
+			n += 1 + l + sovPushService(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Peer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovPushService(uint64(m.Type))
+	}
+	if m.Id != 0 {
+		n += 1 + sovPushService(uint64(m.Id))
+	}
+	l = len(m.StrId)
+	if l > 0 {
+		n += 1 + l + sovPushService(uint64(l))
+	}
+	return n
+}
+
+func (m *OutPeer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovPushService(uint64(m.Type))
+	}
+	if m.Id != 0 {
+		n += 1 + sovPushService(uint64(m.Id))
+	}
+	if m.AccessHash != 0 {
+		n += 1 + sovPushService(uint64(m.AccessHash))
+	}
+	l = len(m.StrId)
+	if l > 0 {
+		n += 1 + l + sovPushService(uint64(l))
+	}
+	return n
+}
+
+func (m *MergeCallModel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPushService(uint64(l))
+	}
+	if m.Merge {
+		n += 2
+	}
+	return n
+}
+
+func (m *AlertingPush) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AlertBody != nil {
+		n += m.AlertBody.Size()
+	}
+	if m.AlertTitle != nil {
+		n += m.AlertTitle.Size()
+	}
+	if m.Badge != 0 {
+		n += 1 + sovPushService(uint64(m.Badge))
+	}
+	if m.Peer != nil {
+		l = m.Peer.Size()
+		n += 1 + l + sovPushService(uint64(l))
+	}
+	if m.Mid != nil {
+		l = m.Mid.Size()
+		n += 1 + l + sovPushService(uint64(l))
+	}
+	if m.Category != nil {
+		l = m.Category.Size()
+		n += 1 + l + sovPushService(uint64(l))
+	}
+	return n
+}
+
+func (m *AlertingPush_LocAlertBody) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LocAlertBody != nil {
+		l = m.LocAlertBody.Size()
+		n += 1 + l + sovPushService(uint64(l))
+	}
+	return n
+}
+func (m *AlertingPush_SimpleAlertBody) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SimpleAlertBody)
+	n += 1 + l + sovPushService(uint64(l))
+	return n
+}
+func (m *AlertingPush_LocAlertTitle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LocAlertTitle != nil {
+		l = m.LocAlertTitle.Size()
+		n += 1 + l + sovPushService(uint64(l))
+	}
+	return n
+}
+func (m *AlertingPush_SimpleAlertTitle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SimpleAlertTitle)
+	n += 1 + l + sovPushService(uint64(l))
+	return n
+}
+func (m *VoipPush) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CallId != 0 {
+		n += 1 + sovPushService(uint64(m.CallId))
+	}
+	if m.AttemptIndex != 0 {
+		n += 1 + sovPushService(uint64(m.AttemptIndex))
+	}
+	l = len(m.DisplayName)
+	if l > 0 {
+		n += 1 + l + sovPushService(uint64(l))
+	}
+	l = len(m.EventBusId)
+	if l > 0 {
+		n += 1 + l + sovPushService(uint64(l))
+	}
+	if m.Peer != nil {
+		l = m.Peer.Size()
+		n += 1 + l + sovPushService(uint64(l))
+	}
+	l = len(m.UpdateType)
+	if l > 0 {
+		n += 1 + l + sovPushService(uint64(l))
+	}
+	l = len(m.DisposalReason)
+	if l > 0 {
+		n += 1 + l + sovPushService(uint64(l))
+	}
+	if m.OutPeer != nil {
+		l = m.OutPeer.Size()
+		n += 1 + l + sovPushService(uint64(l))
+	}
+	if m.Video {
+		n += 2
+	}
+	l = len(m.CallIdStr)
+	if l > 0 {
+		n += 1 + l + sovPushService(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovPushService(uint64(l))
+	}
+	l = len(m.Endpoint)
+	if l > 0 {
+		n += 1 + l + sovPushService(uint64(l))
+	}
+	if m.Merge != nil {
+		l = m.Merge.Size()
+		n += 1 + l + sovPushService(uint64(l))
+	}
+	return n
+}
+
+func (m *EncryptedPush) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PublicAlertingPush != nil {
+		l = m.PublicAlertingPush.Size()
+		n += 1 + l + sovPushService(uint64(l))
+	}
+	l = len(m.EncryptedData)
+	if l > 0 {
+		n += 1 + l + sovPushService(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovPushService(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *ReadPush) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Peer != nil {
+		l = m.Peer.Size()
+		n += 1 + l + sovPushService(uint64(l))
+	}
+	if m.LastReadDate != 0 {
+		n += 1 + sovPushService(uint64(m.LastReadDate))
+	}
+	return n
+}
+
+func (m *PushBody) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollapseKey)
+	if l > 0 {
+		n += 1 + l + sovPushService(uint64(l))
+	}
+	if m.TimeToLive != 0 {
+		n += 1 + sovPushService(uint64(m.TimeToLive))
+	}
+	if m.Seq != 0 {
+		n += 1 + sovPushService(uint64(m.Seq))
+	}
+	if m.Body != nil {
+		n += m.Body.Size()
+	}
+	return n
+}
+
+func (m *PushBody_SilentPush) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SilentPush != nil {
+		l = m.SilentPush.Size()
+		n += 1 + l + sovPushService(uint64(l))
+	}
+	return n
+}
+func (m *PushBody_AlertingPush) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AlertingPush != nil {
+		l = m.AlertingPush.Size()
+		n += 1 + l + sovPushService(uint64(l))
+	}
+	return n
+}
+func (m *PushBody_VoipPush) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VoipPush != nil {
+		l = m.VoipPush.Size()
+		n += 1 + l + sovPushService(uint64(l))
+	}
+	return n
+}
+func (m *PushBody_EncryptedPush) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EncryptedPush != nil {
+		l = m.EncryptedPush.Size()
+		n += 1 + l + sovPushService(uint64(l))
+	}
+	return n
+}
+func (m *PushBody_ReadPush) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReadPush != nil {
+		l = m.ReadPush.Size()
+		n += 1 + l + sovPushService(uint64(l))
+	}
+	return n
+}
+func (m *DeviceIdList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DeviceIds) > 0 {
+		for _, s := range m.DeviceIds {
+			l = len(s)
+			n += 1 + l + sovPushService(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Push) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Destinations) > 0 {
+		for k, v := range m.Destinations {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovPushService(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovPushService(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovPushService(uint64(mapEntrySize))
+		}
+	}
+	if m.Body != nil {
+		l = m.Body.Size()
+		n += 1 + l + sovPushService(uint64(l))
+	}
+	l = len(m.CorrelationId)
+	if l > 0 {
+		n += 1 + l + sovPushService(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovPushService(uint64(m.Priority))
+	}
+	return n
+}
+
+func (m *DeviceResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DeviceId)
+	if l > 0 {
+		n += 1 + l + sovPushService(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovPushService(uint64(m.Status))
+	}
+	l = len(m.MessageId)
+	if l > 0 {
+		n += 1 + l + sovPushService(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovPushService(uint64(l))
+	}
+	if m.UnregisteredAt != 0 {
+		n += 1 + sovPushService(uint64(m.UnregisteredAt))
+	}
+	return n
+}
+
+func (m *DeviceResultList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovPushService(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TokenReplacements) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
+		for k, v := range m.Tokens {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPushService(uint64(len(k))) + 1 + len(v) + sovPushService(uint64(len(v)))
+			n += mapEntrySize + 1 + sovPushService(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *PushError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPushService(uint64(m.Code))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovPushService(uint64(l))
+	}
+	return n
+}
+
+func (m *Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ProjectInvalidations) > 0 {
+		for k, v := range m.ProjectInvalidations {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovPushService(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovPushService(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovPushService(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Results) > 0 {
+		for k, v := range m.Results {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovPushService(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovPushService(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovPushService(uint64(mapEntrySize))
+		}
+	}
+	if len(m.ProjectReplacements) > 0 {
+		for k, v := range m.ProjectReplacements {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovPushService(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovPushService(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovPushService(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.CorrelationId)
+	if l > 0 {
+		n += 1 + l + sovPushService(uint64(l))
+	}
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovPushService(uint64(l))
+	}
+	return n
+}
+
+func (m *PingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *PongResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AppleCredentials) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pem)
+	if l > 0 {
+		n += 1 + l + sovPushService(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPushService(uint64(l))
+	}
+	l = len(m.KeyId)
+	if l > 0 {
+		n += 1 + l + sovPushService(uint64(l))
+	}
+	l = len(m.TeamId)
+	if l > 0 {
+		n += 1 + l + sovPushService(uint64(l))
+	}
+	if m.Voip {
+		n += 2
+	}
+	return n
+}
+
+func (m *FcmCredentials) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ServiceAccount)
+	if l > 0 {
+		n += 1 + l + sovPushService(uint64(l))
+	}
+	return n
+}
+
+func (m *GoogleCredentials) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ServerKey)
+	if l > 0 {
+		n += 1 + l + sovPushService(uint64(l))
+	}
+	return n
+}
+
+func (m *ProjectSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProjectId)
+	if l > 0 {
+		n += 1 + l + sovPushService(uint64(l))
+	}
+	if m.Sandbox {
+		n += 2
+	}
+	if m.NopMode {
+		n += 2
+	}
+	if m.AllowAlerts {
+		n += 2
+	}
+	l = len(m.Topic)
+	if l > 0 {
+		n += 1 + l + sovPushService(uint64(l))
+	}
+	l = len(m.Sound)
+	if l > 0 {
+		n += 1 + l + sovPushService(uint64(l))
+	}
+	if m.Credentials != nil {
+		n += m.Credentials.Size()
+	}
+	return n
+}
+
+func (m *ProjectSpec_Apple) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Apple != nil {
+		l = m.Apple.Size()
+		n += 1 + l + sovPushService(uint64(l))
+	}
+	return n
+}
+func (m *ProjectSpec_Fcm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Fcm != nil {
+		l = m.Fcm.Size()
+		n += 1 + l + sovPushService(uint64(l))
+	}
+	return n
+}
+func (m *ProjectSpec_Google) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Google != nil {
+		l = m.Google.Size()
+		n += 1 + l + sovPushService(uint64(l))
+	}
+	return n
+}
+func (m *ProjectInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProjectId)
+	if l > 0 {
+		n += 1 + l + sovPushService(uint64(l))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovPushService(uint64(l))
+	}
+	if m.Sandbox {
+		n += 2
+	}
+	if m.NopMode {
+		n += 2
+	}
+	if m.Healthy {
+		n += 2
+	}
+	if m.Source != 0 {
+		n += 1 + sovPushService(uint64(m.Source))
+	}
+	return n
+}
+
+func (m *ListProjectsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ListProjectsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Projects) > 0 {
+		for _, e := range m.Projects {
+			l = e.Size()
+			n += 1 + l + sovPushService(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DeleteProjectRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProjectId)
+	if l > 0 {
+		n += 1 + l + sovPushService(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteProjectResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovPushService(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPushService(x uint64) (n int) {
+	return sovPushService(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *SilentPush) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SilentPush{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Localizeable) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Localizeable{`,
+		`LocKey:` + fmt.Sprintf("%v", this.LocKey) + `,`,
+		`LocArgs:` + fmt.Sprintf("%v", this.LocArgs) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Peer) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Peer{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`StrId:` + fmt.Sprintf("%v", this.StrId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OutPeer) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OutPeer{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`AccessHash:` + fmt.Sprintf("%v", this.AccessHash) + `,`,
+		`StrId:` + fmt.Sprintf("%v", this.StrId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MergeCallModel) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MergeCallModel{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Merge:` + fmt.Sprintf("%v", this.Merge) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AlertingPush) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AlertingPush{`,
+		`AlertBody:` + fmt.Sprintf("%v", this.AlertBody) + `,`,
+		`AlertTitle:` + fmt.Sprintf("%v", this.AlertTitle) + `,`,
+		`Badge:` + fmt.Sprintf("%v", this.Badge) + `,`,
+		`Peer:` + strings.Replace(this.Peer.String(), "Peer", "Peer", 1) + `,`,
+		`Mid:` + strings.Replace(fmt.Sprintf("%v", this.Mid), "StringValue", "types.StringValue", 1) + `,`,
+		`Category:` + strings.Replace(fmt.Sprintf("%v", this.Category), "StringValue", "types.StringValue", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AlertingPush_LocAlertBody) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AlertingPush_LocAlertBody{`,
+		`LocAlertBody:` + strings.Replace(fmt.Sprintf("%v", this.LocAlertBody), "Localizeable", "Localizeable", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AlertingPush_SimpleAlertBody) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AlertingPush_SimpleAlertBody{`,
+		`SimpleAlertBody:` + fmt.Sprintf("%v", this.SimpleAlertBody) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AlertingPush_LocAlertTitle) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AlertingPush_LocAlertTitle{`,
+		`LocAlertTitle:` + strings.Replace(fmt.Sprintf("%v", this.LocAlertTitle), "Localizeable", "Localizeable", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AlertingPush_SimpleAlertTitle) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AlertingPush_SimpleAlertTitle{`,
+		`SimpleAlertTitle:` + fmt.Sprintf("%v", this.SimpleAlertTitle) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VoipPush) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VoipPush{`,
+		`CallId:` + fmt.Sprintf("%v", this.CallId) + `,`,
+		`AttemptIndex:` + fmt.Sprintf("%v", this.AttemptIndex) + `,`,
+		`DisplayName:` + fmt.Sprintf("%v", this.DisplayName) + `,`,
+		`EventBusId:` + fmt.Sprintf("%v", this.EventBusId) + `,`,
+		`Peer:` + strings.Replace(this.Peer.String(), "Peer", "Peer", 1) + `,`,
+		`UpdateType:` + fmt.Sprintf("%v", this.UpdateType) + `,`,
+		`DisposalReason:` + fmt.Sprintf("%v", this.DisposalReason) + `,`,
+		`OutPeer:` + strings.Replace(this.OutPeer.String(), "OutPeer", "OutPeer", 1) + `,`,
+		`Video:` + fmt.Sprintf("%v", this.Video) + `,`,
+		`CallIdStr:` + fmt.Sprintf("%v", this.CallIdStr) + `,`,
+		`Token:` + fmt.Sprintf("%v", this.Token) + `,`,
+		`Endpoint:` + fmt.Sprintf("%v", this.Endpoint) + `,`,
+		`Merge:` + strings.Replace(this.Merge.String(), "MergeCallModel", "MergeCallModel", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EncryptedPush) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EncryptedPush{`,
+		`PublicAlertingPush:` + strings.Replace(this.PublicAlertingPush.String(), "AlertingPush", "AlertingPush", 1) + `,`,
+		`EncryptedData:` + fmt.Sprintf("%v", this.EncryptedData) + `,`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReadPush) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReadPush{`,
+		`Peer:` + strings.Replace(this.Peer.String(), "Peer", "Peer", 1) + `,`,
+		`LastReadDate:` + fmt.Sprintf("%v", this.LastReadDate) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PushBody) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PushBody{`,
+		`CollapseKey:` + fmt.Sprintf("%v", this.CollapseKey) + `,`,
+		`TimeToLive:` + fmt.Sprintf("%v", this.TimeToLive) + `,`,
+		`Seq:` + fmt.Sprintf("%v", this.Seq) + `,`,
+		`Body:` + fmt.Sprintf("%v", this.Body) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PushBody_SilentPush) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PushBody_SilentPush{`,
+		`SilentPush:` + strings.Replace(fmt.Sprintf("%v", this.SilentPush), "SilentPush", "SilentPush", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PushBody_AlertingPush) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PushBody_AlertingPush{`,
+		`AlertingPush:` + strings.Replace(fmt.Sprintf("%v", this.AlertingPush), "AlertingPush", "AlertingPush", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PushBody_VoipPush) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PushBody_VoipPush{`,
+		`VoipPush:` + strings.Replace(fmt.Sprintf("%v", this.VoipPush), "VoipPush", "VoipPush", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PushBody_EncryptedPush) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PushBody_EncryptedPush{`,
+		`EncryptedPush:` + strings.Replace(fmt.Sprintf("%v", this.EncryptedPush), "EncryptedPush", "EncryptedPush", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PushBody_ReadPush) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PushBody_ReadPush{`,
+		`ReadPush:` + strings.Replace(fmt.Sprintf("%v", this.ReadPush), "ReadPush", "ReadPush", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeviceIdList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeviceIdList{`,
+		`DeviceIds:` + fmt.Sprintf("%v", this.DeviceIds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Push) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForDestinations := make([]string, 0, len(this.Destinations))
+	for k, _ := range this.Destinations {
+		keysForDestinations = append(keysForDestinations, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForDestinations)
+	mapStringForDestinations := "map[string]*DeviceIdList{"
+	for _, k := range keysForDestinations {
+		mapStringForDestinations += fmt.Sprintf("%v: %v,", k, this.Destinations[k])
+	}
+	mapStringForDestinations += "}"
+	s := strings.Join([]string{`&Push{`,
+		`Destinations:` + mapStringForDestinations + `,`,
+		`Body:` + strings.Replace(this.Body.String(), "PushBody", "PushBody", 1) + `,`,
+		`CorrelationId:` + fmt.Sprintf("%v", this.CorrelationId) + `,`,
+		`Priority:` + fmt.Sprintf("%v", this.Priority) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeviceResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeviceResult{`,
+		`DeviceId:` + fmt.Sprintf("%v", this.DeviceId) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`MessageId:` + fmt.Sprintf("%v", this.MessageId) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`UnregisteredAt:` + fmt.Sprintf("%v", this.UnregisteredAt) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeviceResultList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForResults := "[]*DeviceResult{"
+	for _, f := range this.Results {
+		repeatedStringForResults += strings.Replace(f.String(), "DeviceResult", "DeviceResult", 1) + ","
+	}
+	repeatedStringForResults += "}"
+	s := strings.Join([]string{`&DeviceResultList{`,
+		`Results:` + repeatedStringForResults + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TokenReplacements) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForTokens := make([]string, 0, len(this.Tokens))
+	for k, _ := range this.Tokens {
+		keysForTokens = append(keysForTokens, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForTokens)
+	mapStringForTokens := "map[string]string{"
+	for _, k := range keysForTokens {
+		mapStringForTokens += fmt.Sprintf("%v: %v,", k, this.Tokens[k])
+	}
+	mapStringForTokens += "}"
+	s := strings.Join([]string{`&TokenReplacements{`,
+		`Tokens:` + mapStringForTokens + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PushError) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PushError{`,
+		`Code:` + fmt.Sprintf("%v", this.Code) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Response) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForProjectInvalidations := make([]string, 0, len(this.ProjectInvalidations))
+	for k, _ := range this.ProjectInvalidations {
+		keysForProjectInvalidations = append(keysForProjectInvalidations, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForProjectInvalidations)
+	mapStringForProjectInvalidations := "map[string]*DeviceIdList{"
+	for _, k := range keysForProjectInvalidations {
+		mapStringForProjectInvalidations += fmt.Sprintf("%v: %v,", k, this.ProjectInvalidations[k])
+	}
+	mapStringForProjectInvalidations += "}"
+	keysForResults := make([]string, 0, len(this.Results))
+	for k, _ := range this.Results {
+		keysForResults = append(keysForResults, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForResults)
+	mapStringForResults := "map[string]*DeviceResultList{"
+	for _, k := range keysForResults {
+		mapStringForResults += fmt.Sprintf("%v: %v,", k, this.Results[k])
+	}
+	mapStringForResults += "}"
+	keysForProjectReplacements := make([]string, 0, len(this.ProjectReplacements))
+	for k, _ := range this.ProjectReplacements {
+		keysForProjectReplacements = append(keysForProjectReplacements, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForProjectReplacements)
+	mapStringForProjectReplacements := "map[string]*TokenReplacements{"
+	for _, k := range keysForProjectReplacements {
+		mapStringForProjectReplacements += fmt.Sprintf("%v: %v,", k, this.ProjectReplacements[k])
+	}
+	mapStringForProjectReplacements += "}"
+	s := strings.Join([]string{`&Response{`,
+		`ProjectInvalidations:` + mapStringForProjectInvalidations + `,`,
+		`Results:` + mapStringForResults + `,`,
+		`ProjectReplacements:` + mapStringForProjectReplacements + `,`,
+		`CorrelationId:` + fmt.Sprintf("%v", this.CorrelationId) + `,`,
+		`Error:` + strings.Replace(this.Error.String(), "PushError", "PushError", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PingRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PingRequest{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PongResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PongResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AppleCredentials) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AppleCredentials{`,
+		`Pem:` + fmt.Sprintf("%v", this.Pem) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`KeyId:` + fmt.Sprintf("%v", this.KeyId) + `,`,
+		`TeamId:` + fmt.Sprintf("%v", this.TeamId) + `,`,
+		`Voip:` + fmt.Sprintf("%v", this.Voip) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FcmCredentials) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FcmCredentials{`,
+		`ServiceAccount:` + fmt.Sprintf("%v", this.ServiceAccount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GoogleCredentials) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GoogleCredentials{`,
+		`ServerKey:` + fmt.Sprintf("%v", this.ServerKey) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProjectSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProjectSpec{`,
+		`ProjectId:` + fmt.Sprintf("%v", this.ProjectId) + `,`,
+		`Sandbox:` + fmt.Sprintf("%v", this.Sandbox) + `,`,
+		`NopMode:` + fmt.Sprintf("%v", this.NopMode) + `,`,
+		`AllowAlerts:` + fmt.Sprintf("%v", this.AllowAlerts) + `,`,
+		`Topic:` + fmt.Sprintf("%v", this.Topic) + `,`,
+		`Sound:` + fmt.Sprintf("%v", this.Sound) + `,`,
+		`Credentials:` + fmt.Sprintf("%v", this.Credentials) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProjectSpec_Apple) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProjectSpec_Apple{`,
+		`Apple:` + strings.Replace(fmt.Sprintf("%v", this.Apple), "AppleCredentials", "AppleCredentials", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProjectSpec_Fcm) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProjectSpec_Fcm{`,
+		`Fcm:` + strings.Replace(fmt.Sprintf("%v", this.Fcm), "FcmCredentials", "FcmCredentials", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProjectSpec_Google) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProjectSpec_Google{`,
+		`Google:` + strings.Replace(fmt.Sprintf("%v", this.Google), "GoogleCredentials", "GoogleCredentials", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProjectInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProjectInfo{`,
+		`ProjectId:` + fmt.Sprintf("%v", this.ProjectId) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Sandbox:` + fmt.Sprintf("%v", this.Sandbox) + `,`,
+		`NopMode:` + fmt.Sprintf("%v", this.NopMode) + `,`,
+		`Healthy:` + fmt.Sprintf("%v", this.Healthy) + `,`,
+		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListProjectsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListProjectsRequest{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListProjectsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForProjects := "[]*ProjectInfo{"
+	for _, f := range this.Projects {
+		repeatedStringForProjects += strings.Replace(f.String(), "ProjectInfo", "ProjectInfo", 1) + ","
+	}
+	repeatedStringForProjects += "}"
+	s := strings.Join([]string{`&ListProjectsResponse{`,
+		`Projects:` + repeatedStringForProjects + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteProjectRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteProjectRequest{`,
+		`ProjectId:` + fmt.Sprintf("%v", this.ProjectId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteProjectResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteProjectResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringPushService(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *SilentPush) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPushService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SilentPush: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SilentPush: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPushService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Localizeable) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPushService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Localizeable: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Localizeable: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPushService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LocKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocArgs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPushService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LocArgs = append(m.LocArgs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPushService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Peer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPushService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Peer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Peer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= PeerType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPushService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StrId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPushService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutPeer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPushService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutPeer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutPeer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= PeerType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessHash", wireType)
+			}
+			m.AccessHash = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccessHash |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPushService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StrId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPushService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MergeCallModel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPushService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MergeCallModel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MergeCallModel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPushService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Merge", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Merge = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPushService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AlertingPush) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPushService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlertingPush: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlertingPush: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocAlertBody", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPushService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Localizeable{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.AlertBody = &AlertingPush_LocAlertBody{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SimpleAlertBody", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPushService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AlertBody = &AlertingPush_SimpleAlertBody{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocAlertTitle", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPushService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Localizeable{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.AlertTitle = &AlertingPush_LocAlertTitle{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SimpleAlertTitle", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPushService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AlertTitle = &AlertingPush_SimpleAlertTitle{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Badge", wireType)
+			}
+			m.Badge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Badge |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPushService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Peer == nil {
+				m.Peer = &Peer{}
+			}
+			if err := m.Peer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPushService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mid == nil {
+				m.Mid = &types.StringValue{}
+			}
+			if err := m.Mid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPushService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Category == nil {
+				m.Category = &types.StringValue{}
+			}
+			if err := m.Category.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPushService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VoipPush) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPushService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoipPush: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoipPush: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallId", wireType)
+			}
+			m.CallId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CallId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttemptIndex", wireType)
+			}
+			m.AttemptIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttemptIndex |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPushService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventBusId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPushService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventBusId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPushService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Peer == nil {
+				m.Peer = &Peer{}
+			}
+			if err := m.Peer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPushService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdateType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisposalReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPushService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisposalReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutPeer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPushService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OutPeer == nil {
+				m.OutPeer = &OutPeer{}
+			}
+			if err := m.OutPeer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Video", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Video = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallIdStr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPushService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallIdStr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPushService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPushService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Merge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPushService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Merge == nil {
+				m.Merge = &MergeCallModel{}
+			}
+			if err := m.Merge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPushService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EncryptedPush) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPushService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EncryptedPush: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EncryptedPush: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicAlertingPush", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPushService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PublicAlertingPush == nil {
+				m.PublicAlertingPush = &AlertingPush{}
+			}
+			if err := m.PublicAlertingPush.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPushService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptedData = append(m.EncryptedData[:0], dAtA[iNdEx:postIndex]...)
+			if m.EncryptedData == nil {
+				m.EncryptedData = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPushService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReadPush) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPushService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReadPush: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReadPush: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPushService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Peer == nil {
+				m.Peer = &Peer{}
+			}
+			if err := m.Peer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastReadDate", wireType)
+			}
+			m.LastReadDate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastReadDate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPushService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PushBody) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPushService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PushBody: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PushBody: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollapseKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPushService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollapseKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeToLive", wireType)
+			}
+			m.TimeToLive = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeToLive |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seq |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SilentPush", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPushService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SilentPush{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &PushBody_SilentPush{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlertingPush", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPushService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AlertingPush{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &PushBody_AlertingPush{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoipPush", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPushService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &VoipPush{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &PushBody_VoipPush{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedPush", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPushService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EncryptedPush{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &PushBody_EncryptedPush{v}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadPush", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPushService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ReadPush{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &PushBody_ReadPush{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPushService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeviceIdList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPushService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeviceIdList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeviceIdList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPushService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeviceIds = append(m.DeviceIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPushService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	s := strings.Join([]string{`&PongResponse{`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringPushService(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *SilentPush) Unmarshal(dAtA []byte) error {
+func (m *Push) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4046,25 +9073,241 @@ func (m *SilentPush) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowPushService
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Push: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Push: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destinations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPushService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Destinations == nil {
+				m.Destinations = make(map[string]*DeviceIdList)
+			}
+			var mapkey string
+			var mapvalue *DeviceIdList
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPushService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPushService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPushService
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthPushService
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPushService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthPushService
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthPushService
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &DeviceIdList{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPushService(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthPushService
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Destinations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPushService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Body == nil {
+				m.Body = &PushBody{}
+			}
+			if err := m.Body.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrelationId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPushService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.CorrelationId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= Priority(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SilentPush: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SilentPush: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPushService(dAtA[iNdEx:])
@@ -4089,7 +9332,7 @@ func (m *SilentPush) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Localizeable) Unmarshal(dAtA []byte) error {
+func (m *DeviceResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4112,15 +9355,15 @@ func (m *Localizeable) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Localizeable: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeviceResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Localizeable: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeviceResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LocKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4148,11 +9391,30 @@ func (m *Localizeable) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LocKey = string(dAtA[iNdEx:postIndex])
+			m.DeviceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= DeliveryStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LocArgs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4180,8 +9442,59 @@ func (m *Localizeable) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LocArgs = append(m.LocArgs, string(dAtA[iNdEx:postIndex]))
+			m.MessageId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPushService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnregisteredAt", wireType)
+			}
+			m.UnregisteredAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnregisteredAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPushService(dAtA[iNdEx:])
@@ -4206,7 +9519,7 @@ func (m *Localizeable) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Peer) Unmarshal(dAtA []byte) error {
+func (m *DeviceResultList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4229,55 +9542,17 @@ func (m *Peer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Peer: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeviceResultList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Peer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeviceResultList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPushService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= PeerType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPushService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StrId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPushService
@@ -4287,23 +9562,25 @@ func (m *Peer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPushService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPushService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StrId = string(dAtA[iNdEx:postIndex])
+			m.Results = append(m.Results, &DeviceResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4329,7 +9606,7 @@ func (m *Peer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OutPeer) Unmarshal(dAtA []byte) error {
+func (m *TokenReplacements) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4352,74 +9629,17 @@ func (m *OutPeer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OutPeer: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OutPeer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenReplacements: wiretype end group for non-group")
 		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPushService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= PeerType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPushService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessHash", wireType)
-			}
-			m.AccessHash = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPushService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AccessHash |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenReplacements: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StrId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPushService
@@ -4429,23 +9649,118 @@ func (m *OutPeer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPushService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPushService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StrId = string(dAtA[iNdEx:postIndex])
+			if m.Tokens == nil {
+				m.Tokens = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPushService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPushService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPushService
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthPushService
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPushService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthPushService
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthPushService
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPushService(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthPushService
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Tokens[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4471,7 +9786,7 @@ func (m *OutPeer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MergeCallModel) Unmarshal(dAtA []byte) error {
+func (m *PushError) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4494,17 +9809,17 @@ func (m *MergeCallModel) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MergeCallModel: wiretype end group for non-group")
+			return fmt.Errorf("proto: PushError: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MergeCallModel: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PushError: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			var stringLen uint64
+			m.Code = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPushService
@@ -4514,29 +9829,16 @@ func (m *MergeCallModel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Code |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPushService
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPushService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Merge", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPushService
@@ -4546,12 +9848,24 @@ func (m *MergeCallModel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Merge = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPushService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPushService(dAtA[iNdEx:])
@@ -4576,7 +9890,7 @@ func (m *MergeCallModel) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AlertingPush) Unmarshal(dAtA []byte) error {
+func (m *Response) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4599,15 +9913,15 @@ func (m *AlertingPush) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AlertingPush: wiretype end group for non-group")
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AlertingPush: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LocAlertBody", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectInvalidations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4634,47 +9948,109 @@ func (m *AlertingPush) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &Localizeable{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.AlertBody = &AlertingPush_LocAlertBody{v}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SimpleAlertBody", wireType)
+			if m.ProjectInvalidations == nil {
+				m.ProjectInvalidations = make(map[string]*DeviceIdList)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPushService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue *DeviceIdList
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPushService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPushService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPushService
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthPushService
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPushService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthPushService
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthPushService
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &DeviceIdList{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPushService(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthPushService
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPushService
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPushService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AlertBody = &AlertingPush_SimpleAlertBody{string(dAtA[iNdEx:postIndex])}
+			m.ProjectInvalidations[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LocAlertTitle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4701,17 +10077,111 @@ func (m *AlertingPush) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &Localizeable{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Results == nil {
+				m.Results = make(map[string]*DeviceResultList)
 			}
-			m.AlertTitle = &AlertingPush_LocAlertTitle{v}
+			var mapkey string
+			var mapvalue *DeviceResultList
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPushService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPushService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPushService
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthPushService
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPushService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthPushService
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthPushService
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &DeviceResultList{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPushService(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthPushService
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Results[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SimpleAlertTitle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectReplacements", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPushService
@@ -4721,84 +10191,126 @@ func (m *AlertingPush) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPushService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPushService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AlertTitle = &AlertingPush_SimpleAlertTitle{string(dAtA[iNdEx:postIndex])}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Badge", wireType)
-			}
-			m.Badge = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPushService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Badge |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
+			if m.ProjectReplacements == nil {
+				m.ProjectReplacements = make(map[string]*TokenReplacements)
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPushService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue *TokenReplacements
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPushService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPushService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPushService
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthPushService
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPushService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthPushService
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthPushService
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &TokenReplacements{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPushService(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthPushService
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPushService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPushService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Peer == nil {
-				m.Peer = &Peer{}
-			}
-			if err := m.Peer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ProjectReplacements[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrelationId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPushService
@@ -4808,31 +10320,27 @@ func (m *AlertingPush) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPushService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPushService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Mid == nil {
-				m.Mid = &types.StringValue{}
-			}
-			if err := m.Mid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CorrelationId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4859,10 +10367,10 @@ func (m *AlertingPush) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Category == nil {
-				m.Category = &types.StringValue{}
+			if m.Error == nil {
+				m.Error = &PushError{}
 			}
-			if err := m.Category.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4890,7 +10398,7 @@ func (m *AlertingPush) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VoipPush) Unmarshal(dAtA []byte) error {
+func (m *PingRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4913,219 +10421,123 @@ func (m *VoipPush) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VoipPush: wiretype end group for non-group")
+			return fmt.Errorf("proto: PingRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VoipPush: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CallId", wireType)
-			}
-			m.CallId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPushService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CallId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AttemptIndex", wireType)
-			}
-			m.AttemptIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPushService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AttemptIndex |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPushService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPushService
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPushService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DisplayName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventBusId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPushService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPushService
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPushService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EventBusId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPushService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPushService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPushService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Peer == nil {
-				m.Peer = &Peer{}
-			}
-			if err := m.Peer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPushService(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPushService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthPushService
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPushService
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdateType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisposalReason", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PongResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPushService
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPushService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PongResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PongResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPushService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthPushService
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPushService
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DisposalReason = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AppleCredentials) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPushService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppleCredentials: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppleCredentials: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutPeer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pem", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPushService
@@ -5135,53 +10547,31 @@ func (m *VoipPush) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPushService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPushService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OutPeer == nil {
-				m.OutPeer = &OutPeer{}
-			}
-			if err := m.OutPeer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Pem = append(m.Pem[:0], dAtA[iNdEx:postIndex]...)
+			if m.Pem == nil {
+				m.Pem = []byte{}
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Video", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPushService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Video = bool(v != 0)
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CallIdStr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPushService
@@ -5191,27 +10581,29 @@ func (m *VoipPush) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPushService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPushService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CallIdStr = string(dAtA[iNdEx:postIndex])
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
 			iNdEx = postIndex
-		case 11:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5239,11 +10631,11 @@ func (m *VoipPush) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Token = string(dAtA[iNdEx:postIndex])
+			m.KeyId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TeamId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5271,13 +10663,13 @@ func (m *VoipPush) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			m.TeamId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Merge", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voip", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPushService
@@ -5287,28 +10679,12 @@ func (m *VoipPush) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPushService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPushService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Merge == nil {
-				m.Merge = &MergeCallModel{}
-			}
-			if err := m.Merge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Voip = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPushService(dAtA[iNdEx:])
@@ -5333,7 +10709,7 @@ func (m *VoipPush) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EncryptedPush) Unmarshal(dAtA []byte) error {
+func (m *FcmCredentials) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5356,51 +10732,15 @@ func (m *EncryptedPush) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EncryptedPush: wiretype end group for non-group")
+			return fmt.Errorf("proto: FcmCredentials: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EncryptedPush: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FcmCredentials: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicAlertingPush", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPushService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPushService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPushService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.PublicAlertingPush == nil {
-				m.PublicAlertingPush = &AlertingPush{}
-			}
-			if err := m.PublicAlertingPush.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccount", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5427,30 +10767,11 @@ func (m *EncryptedPush) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EncryptedData = append(m.EncryptedData[:0], dAtA[iNdEx:postIndex]...)
-			if m.EncryptedData == nil {
-				m.EncryptedData = []byte{}
+			m.ServiceAccount = append(m.ServiceAccount[:0], dAtA[iNdEx:postIndex]...)
+			if m.ServiceAccount == nil {
+				m.ServiceAccount = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPushService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPushService(dAtA[iNdEx:])
@@ -5475,7 +10796,7 @@ func (m *EncryptedPush) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReadPush) Unmarshal(dAtA []byte) error {
+func (m *GoogleCredentials) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5498,17 +10819,17 @@ func (m *ReadPush) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReadPush: wiretype end group for non-group")
+			return fmt.Errorf("proto: GoogleCredentials: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReadPush: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GoogleCredentials: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerKey", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPushService
@@ -5518,47 +10839,24 @@ func (m *ReadPush) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPushService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPushService
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Peer == nil {
-				m.Peer = &Peer{}
-			}
-			if err := m.Peer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastReadDate", wireType)
-			}
-			m.LastReadDate = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPushService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastReadDate |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServerKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPushService(dAtA[iNdEx:])
@@ -5583,7 +10881,7 @@ func (m *ReadPush) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PushBody) Unmarshal(dAtA []byte) error {
+func (m *ProjectSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5606,15 +10904,15 @@ func (m *PushBody) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PushBody: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PushBody: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollapseKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5642,13 +10940,13 @@ func (m *PushBody) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CollapseKey = string(dAtA[iNdEx:postIndex])
+			m.ProjectId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeToLive", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sandbox", wireType)
 			}
-			m.TimeToLive = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPushService
@@ -5658,16 +10956,17 @@ func (m *PushBody) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TimeToLive |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Sandbox = bool(v != 0)
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NopMode", wireType)
 			}
-			m.Seq = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPushService
@@ -5677,16 +10976,37 @@ func (m *PushBody) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Seq |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.NopMode = bool(v != 0)
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowAlerts", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowAlerts = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SilentPush", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPushService
@@ -5696,32 +11016,29 @@ func (m *PushBody) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPushService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPushService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &SilentPush{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Body = &PushBody_SilentPush{v}
+			m.Topic = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AlertingPush", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sound", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPushService
@@ -5731,30 +11048,27 @@ func (m *PushBody) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPushService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPushService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &AlertingPush{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Body = &PushBody_AlertingPush{v}
+			m.Sound = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoipPush", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Apple", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5781,15 +11095,15 @@ func (m *PushBody) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &VoipPush{}
+			v := &AppleCredentials{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Body = &PushBody_VoipPush{v}
+			m.Credentials = &ProjectSpec_Apple{v}
 			iNdEx = postIndex
-		case 7:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedPush", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fcm", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5816,15 +11130,15 @@ func (m *PushBody) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &EncryptedPush{}
+			v := &FcmCredentials{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Body = &PushBody_EncryptedPush{v}
+			m.Credentials = &ProjectSpec_Fcm{v}
 			iNdEx = postIndex
-		case 8:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadPush", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Google", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5851,11 +11165,11 @@ func (m *PushBody) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ReadPush{}
+			v := &GoogleCredentials{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Body = &PushBody_ReadPush{v}
+			m.Credentials = &ProjectSpec_Google{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5881,7 +11195,7 @@ func (m *PushBody) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeviceIdList) Unmarshal(dAtA []byte) error {
+func (m *ProjectInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5904,15 +11218,15 @@ func (m *DeviceIdList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeviceIdList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeviceIdList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeviceIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5940,66 +11254,13 @@ func (m *DeviceIdList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeviceIds = append(m.DeviceIds, string(dAtA[iNdEx:postIndex]))
+			m.ProjectId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPushService(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPushService
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPushService
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Push) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPushService
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Push: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Push: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destinations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPushService
@@ -6009,126 +11270,29 @@ func (m *Push) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPushService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
-				return ErrInvalidLengthPushService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Destinations == nil {
-				m.Destinations = make(map[string]*DeviceIdList)
-			}
-			var mapkey string
-			var mapvalue *DeviceIdList
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPushService
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPushService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthPushService
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthPushService
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPushService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthPushService
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthPushService
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &DeviceIdList{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPushService(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthPushService
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+				return ErrInvalidLengthPushService
 			}
-			m.Destinations[mapkey] = mapvalue
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sandbox", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPushService
@@ -6138,33 +11302,57 @@ func (m *Push) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPushService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPushService
+			m.Sandbox = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NopMode", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if m.Body == nil {
-				m.Body = &PushBody{}
+			m.NopMode = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Healthy", wireType)
 			}
-			if err := m.Body.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CorrelationId", wireType)
+			m.Healthy = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			var stringLen uint64
+			m.Source = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPushService
@@ -6174,24 +11362,64 @@ func (m *Push) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Source |= ProjectSource(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPushService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthPushService
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPushService
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CorrelationId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListProjectsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPushService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListProjectsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListProjectsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPushService(dAtA[iNdEx:])
@@ -6216,7 +11444,7 @@ func (m *Push) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Response) Unmarshal(dAtA []byte) error {
+func (m *ListProjectsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6239,15 +11467,15 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListProjectsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListProjectsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProjectInvalidations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Projects", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6274,105 +11502,10 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProjectInvalidations == nil {
-				m.ProjectInvalidations = make(map[string]*DeviceIdList)
-			}
-			var mapkey string
-			var mapvalue *DeviceIdList
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPushService
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPushService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthPushService
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthPushService
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPushService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthPushService
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthPushService
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &DeviceIdList{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPushService(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthPushService
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			m.Projects = append(m.Projects, &ProjectInfo{})
+			if err := m.Projects[len(m.Projects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.ProjectInvalidations[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6398,7 +11531,7 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PingRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteProjectRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6421,12 +11554,44 @@ func (m *PingRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PingRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteProjectRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteProjectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPushService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPushService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPushService(dAtA[iNdEx:])
@@ -6451,7 +11616,7 @@ func (m *PingRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PongResponse) Unmarshal(dAtA []byte) error {
+func (m *DeleteProjectResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6474,10 +11639,10 @@ func (m *PongResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PongResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteProjectResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PongResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteProjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -0,0 +1,73 @@
+package api
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"strings"
+
+	gogoproto "github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	"github.com/golang/protobuf/proto"
+	// google/protobuf/wrappers.proto for the server reflection
+	_ "github.com/golang/protobuf/ptypes/wrappers"
+)
+
+const protoFile = "push_service.proto"
+
+// The gogo generated code registers the file descriptor in the gogo registry only.
+// The gRPC server reflection reads the golang/protobuf registry: the descriptor is
+// registered there without the scalapb options (the file isn't known to Go clients)
+func init() {
+
+	fileDescriptor, err := reflectionFileDescriptor(gogoproto.FileDescriptor(protoFile))
+	if err != nil {
+		panic("server reflection: " + err.Error())
+	}
+
+	proto.RegisterFile(protoFile, fileDescriptor)
+}
+
+func reflectionFileDescriptor(src []byte) ([]byte, error) {
+
+	r, err := gzip.NewReader(bytes.NewReader(src))
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	fd := &descriptor.FileDescriptorProto{}
+	if err := gogoproto.Unmarshal(data, fd); err != nil {
+		return nil, err
+	}
+
+	dependency := make([]string, 0, len(fd.Dependency))
+	for _, name := range fd.Dependency {
+		if !strings.HasPrefix(name, "scalapb/") {
+			dependency = append(dependency, name)
+		}
+	}
+	fd.Dependency = dependency
+	fd.Options = nil
+
+	data, err = gogoproto.Marshal(fd)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := &bytes.Buffer{}
+	w := gzip.NewWriter(buf)
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,38 @@
+package atomicfile
+
+import "os"
+
+// TmpExt is the extension of the temporary file. The temporary files
+// left after a crash are incomplete: the readers remove them
+const TmpExt = ".tmp"
+
+// Write writes the data to the temporary file and renames it:
+// the file is replaced atomically
+func Write(path string, data []byte) error {
+
+	tmpPath := path + TmpExt
+	f, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+
+	_, err = f.Write(data)
+	if err == nil {
+		err = f.Sync()
+	}
+
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err == nil {
+		err = os.Rename(tmpPath, path)
+	}
+
+	if err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package atomicfile
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWrite(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "atomicfile")
+	require.NoError(t, err)
+	defer func() { require.NoError(t, os.RemoveAll(dir)) }()
+
+	path := filepath.Join(dir, "file.json")
+	require.NoError(t, Write(path, []byte("1")))
+	require.NoError(t, Write(path, []byte("2")))
+
+	data, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "2", string(data))
+
+	// the temporary file isn't left
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+
+	require.Error(t, Write(filepath.Join(dir, "unknown", "file.json"), []byte("1")))
+}
@@ -4,4 +4,7 @@ type Config struct {
 	AllowAlerts bool   `mapstructure:"allow-alerts"`
 	Sound       string `mapstructure:"sound"`
 	Topic       string `mapstructure:"topic"`
+	// VoIPTopic is the topic of the VoIP pushes. The value is set by the provider config:
+	// the VoIP pushes are sent to Topic if the value is empty
+	VoIPTopic string `mapstructure:"-"`
 }
@@ -5,6 +5,8 @@ import (
 
 	"github.com/dialogs/dialog-push-service/pkg/api"
 	"github.com/dialogs/dialog-push-service/pkg/provider"
+	"github.com/dialogs/dialog-push-service/pkg/provider/ans"
+	"github.com/dialogs/dialog-push-service/pkg/provider/fcm"
 	"github.com/stretchr/testify/require"
 )
 
@@ -39,6 +41,13 @@ func TestIgnoreRequest(t *testing.T) {
 				},
 			},
 		},
+		{
+			Src: &api.PushBody{
+				Body: &api.PushBody_ReadPush{
+					ReadPush: &api.ReadPush{Peer: &api.Peer{Id: 1}},
+				},
+			},
+		},
 		{
 			Src: &api.PushBody{
 				Body: &api.PushBody_SilentPush{
@@ -74,3 +83,140 @@ func TestIgnoreRequest(t *testing.T) {
 	}
 
 }
+
+func TestReadPush(t *testing.T) {
+
+	strPtr := func(src string) *string { return &src }
+
+	src := &api.PushBody{
+		Seq: 7,
+		Body: &api.PushBody_ReadPush{
+			ReadPush: &api.ReadPush{
+				Peer:         &api.Peer{Type: api.Group, Id: 12},
+				LastReadDate: 1565000000000,
+			},
+		},
+	}
+
+	{
+		res, err := RequestPbToAns(src, false, true, strPtr("topic-name"), strPtr("sound-name"))
+		require.NoError(t, err)
+		require.JSONEq(t,
+			`{"aps":{"content-available":1},"peer":{"id":"12","type":"2","strId":""},"lastReadDate":1565000000000,"seq":7}`,
+			string(res.Payload))
+		require.Equal(t, 5, res.Headers.Priority)
+		require.Equal(t, ans.PushTypeBackground, res.Headers.PushType)
+		require.Equal(t, "topic-name", res.Headers.Topic)
+		require.Empty(t, res.Headers.CollapseID)
+	}
+
+	{
+		res, err := RequestPbToFcm(src, true)
+		require.NoError(t, err)
+		require.Nil(t, res.Notification)
+		require.Equal(t,
+			map[string]string{
+				"peer":         `{"id":"12","strId":"","type":"2"}` + "\n",
+				"lastReadDate": "1565000000000",
+				"seq":          "7",
+			},
+			res.Data)
+		require.Equal(t, "read_2_12", res.Android.CollapseKey)
+		require.Equal(t, fcm.AndroidMessagePriorityNormal, res.Android.Priority)
+	}
+
+	{
+		res, err := RequestPbToGcm(src, true)
+		require.NoError(t, err)
+		require.Nil(t, res.Notification)
+		require.JSONEq(t,
+			`{"peer":{"id":"12","type":"2","strId":""},"lastReadDate":1565000000000,"seq":7}`,
+			string(res.Data))
+		require.Equal(t, "read_2_12", res.CollapseKey)
+		require.Equal(t, "normal", res.Priority)
+	}
+
+	{
+		// test: explicit collapse key
+		src.CollapseKey = "collapse-key"
+		src.GetReadPush().Peer.StrId = "str-id"
+
+		resAns, err := RequestPbToAns(src, false, true, nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, "collapse-key", resAns.Headers.CollapseID)
+
+		resFcm, err := RequestPbToFcm(src, true)
+		require.NoError(t, err)
+		require.Equal(t, "collapse-key", resFcm.Android.CollapseKey)
+
+		resGcm, err := RequestPbToGcm(src, true)
+		require.NoError(t, err)
+		require.Equal(t, "collapse-key", resGcm.CollapseKey)
+
+		src.CollapseKey = ""
+		resGcm, err = RequestPbToGcm(src, true)
+		require.NoError(t, err)
+		require.Equal(t, "read_2_str-id", resGcm.CollapseKey)
+	}
+
+	{
+		// test: read push without peer
+		src := &api.PushBody{
+			Body: &api.PushBody_ReadPush{
+				ReadPush: &api.ReadPush{LastReadDate: 1},
+			},
+		}
+
+		_, err := RequestPbToAns(src, false, true, nil, nil)
+		require.Equal(t, ErrReadPushWithoutPeer, err)
+
+		_, err = RequestPbToFcm(src, true)
+		require.Equal(t, ErrReadPushWithoutPeer, err)
+
+		_, err = RequestPbToGcm(src, true)
+		require.Equal(t, ErrReadPushWithoutPeer, err)
+	}
+}
+
+func TestAnsPushType(t *testing.T) {
+
+	for _, testInfo := range []struct {
+		Src      *api.PushBody
+		PushType string
+	}{
+		{
+			Src: &api.PushBody{
+				Body: &api.PushBody_VoipPush{VoipPush: &api.VoipPush{CallId: 1}},
+			},
+			PushType: ans.PushTypeVoIP,
+		},
+		{
+			Src: &api.PushBody{
+				Body: &api.PushBody_AlertingPush{AlertingPush: &api.AlertingPush{
+					AlertBody: &api.AlertingPush_SimpleAlertBody{SimpleAlertBody: "body"},
+				}},
+			},
+			PushType: ans.PushTypeAlert,
+		},
+		{
+			Src: &api.PushBody{
+				Body: &api.PushBody_EncryptedPush{EncryptedPush: &api.EncryptedPush{
+					EncryptedData: []byte("data"),
+				}},
+			},
+			PushType: ans.PushTypeAlert,
+		},
+		{
+			Src: &api.PushBody{
+				Body: &api.PushBody_ReadPush{ReadPush: &api.ReadPush{
+					Peer: &api.Peer{Type: api.Private, Id: 1},
+				}},
+			},
+			PushType: ans.PushTypeBackground,
+		},
+	} {
+		res, err := RequestPbToAns(testInfo.Src, true, true, nil, nil)
+		require.NoError(t, err, testInfo.PushType)
+		require.Equal(t, testInfo.PushType, res.Headers.PushType)
+	}
+}
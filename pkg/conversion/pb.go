@@ -3,6 +3,7 @@ package conversion
 import (
 	"bytes"
 	"errors"
+	"strconv"
 
 	"github.com/dialogs/dialog-push-service/pkg/api"
 	"github.com/gogo/protobuf/jsonpb"
@@ -15,6 +16,7 @@ var (
 	ErrInvalidIncomingPayloadData = errors.New("invalid incoming payload data")
 	ErrEmptyEncryptedPayload      = errors.New("encrypted push without encrypted data")
 	ErrNotSupportedAlertPush      = errors.New("alerting pushes are not supported for FCM")
+	ErrReadPushWithoutPeer        = errors.New("read push without peer")
 )
 
 func ErrorByIncomingMessage(body *api.PushBody) error {
@@ -41,3 +43,23 @@ func PeerTypeProtobufToMPS(peerType api.PeerType) int {
 		return 0
 	}
 }
+
+func peerProtobufToMap(peer *api.Peer) map[string]string {
+	return map[string]string{
+		"id":    strconv.Itoa(int(peer.Id)),
+		"type":  strconv.Itoa(PeerTypeProtobufToMPS(peer.Type)),
+		"strId": peer.StrId,
+	}
+}
+
+// readCollapseKey returns the collapse key of read pushes: the client
+// needs only the last read date of a chat
+func readCollapseKey(peer *api.Peer) string {
+
+	id := peer.StrId
+	if id == "" {
+		id = strconv.Itoa(int(peer.Id))
+	}
+
+	return "read_" + strconv.Itoa(PeerTypeProtobufToMPS(peer.Type)) + "_" + id
+}
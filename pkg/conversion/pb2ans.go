@@ -23,12 +23,22 @@ func RequestPbToAns(in *api.PushBody, supportsVoIP, allowAlerts bool, topic, sou
 	payload := payload.NewPayload()
 	if voip := in.GetVoipPush(); voip != nil {
 		err = setVoIPPayloadAns(payload, voip, supportsVoIP)
+		out.Headers.PushType = ans.PushTypeVoIP
 
 	} else if alerting := in.GetAlertingPush(); alerting != nil {
 		setAlertingPayloadAns(payload, alerting, sound, allowAlerts)
+		out.Headers.PushType = ans.PushTypeAlert
 
 	} else if encryped := in.GetEncryptedPush(); encryped != nil {
 		err = setEncryptedPayload(payload, encryped, sound)
+		out.Headers.PushType = ans.PushTypeAlert
+
+	} else if read := in.GetReadPush(); read != nil {
+		err = setReadPayloadAns(payload, read)
+		// background notification:
+		// https://developer.apple.com/documentation/usernotifications/setting_up_a_remote_notification_server/pushing_background_updates_to_your_app
+		out.Headers.Priority = 5
+		out.Headers.PushType = ans.PushTypeBackground
 
 	} else if silent := in.GetSilentPush(); silent != nil {
 		// ignoring
@@ -160,6 +170,20 @@ func setEncryptedPayload(payload *payload.Payload, src *api.EncryptedPush, sound
 	return nil
 }
 
+func setReadPayloadAns(payload *payload.Payload, src *api.ReadPush) error {
+
+	peer := src.GetPeer()
+	if peer == nil {
+		return ErrReadPushWithoutPeer
+	}
+
+	payload.ContentAvailable()
+	payload.Custom("peer", peerProtobufToMap(peer))
+	payload.Custom("lastReadDate", src.GetLastReadDate())
+
+	return nil
+}
+
 func setAlertPropsAns(payload *payload.Payload, alerting *api.AlertingPush, sound *string) {
 
 	if locAlert := alerting.GetLocAlertTitle(); locAlert != nil {
@@ -18,7 +18,9 @@ func RequestPbToFcm(in *api.PushBody, allowAlerts bool) (*fcm.Message, error) {
 	)
 
 	out.Data = map[string]string{}
-	out.Android = &fcm.AndroidConfig{}
+	out.Android = &fcm.AndroidConfig{
+		Priority: fcm.AndroidMessagePriorityHigh,
+	}
 
 	if voip := in.GetVoipPush(); voip != nil {
 		err = setVoIPPayloadFcm(&out, voip)
@@ -29,6 +31,9 @@ func RequestPbToFcm(in *api.PushBody, allowAlerts bool) (*fcm.Message, error) {
 	} else if alerting := in.GetAlertingPush(); alerting != nil {
 		err = setAlertingPushFcm(&out, alerting, allowAlerts)
 
+	} else if read := in.GetReadPush(); read != nil {
+		err = setReadPushFcm(&out, read)
+
 	} else if silent := in.GetSilentPush(); silent != nil {
 		// ignoring
 
@@ -41,8 +46,6 @@ func RequestPbToFcm(in *api.PushBody, allowAlerts bool) (*fcm.Message, error) {
 		return nil, err
 	}
 
-	out.Android.Priority = fcm.AndroidMessagePriorityHigh
-
 	if collapseKey := in.GetCollapseKey(); len(collapseKey) > 0 {
 		out.Android.CollapseKey = collapseKey
 	}
@@ -132,6 +135,25 @@ func setEncryptedPushFcm(req *fcm.Message, src *api.EncryptedPush) error {
 	return nil
 }
 
+func setReadPushFcm(req *fcm.Message, src *api.ReadPush) error {
+
+	peer := src.GetPeer()
+	if peer == nil {
+		return ErrReadPushWithoutPeer
+	}
+
+	if err := addMapToMap(req.Data, "peer", peerProtobufToMap(peer)); err != nil {
+		return err
+	}
+
+	req.Data["lastReadDate"] = strconv.FormatInt(src.GetLastReadDate(), 10)
+	req.Android.CollapseKey = readCollapseKey(peer)
+	// background sync: don't wake the device
+	req.Android.Priority = fcm.AndroidMessagePriorityNormal
+
+	return nil
+}
+
 func setAlertingPushFcm(req *fcm.Message, src *api.AlertingPush, allowAlerts bool) error {
 
 	if !allowAlerts {
@@ -31,6 +31,9 @@ func RequestPbToGcm(in *api.PushBody, allowAlerts bool) (*gcm.Request, error) {
 		}
 		// if allowAlerts == false, send only required properties
 
+	} else if read := in.GetReadPush(); read != nil {
+		err = setReadPushGcm(&out, data, read)
+
 	} else if silent := in.GetSilentPush(); silent != nil {
 		// ignoring
 
@@ -117,6 +120,22 @@ func setEncryptedPushGcm(req *gcm.Request, data map[string]interface{}, src *api
 	return nil
 }
 
+func setReadPushGcm(req *gcm.Request, data map[string]interface{}, src *api.ReadPush) error {
+
+	peer := src.GetPeer()
+	if peer == nil {
+		return ErrReadPushWithoutPeer
+	}
+
+	data["peer"] = peerProtobufToMap(peer)
+	data["lastReadDate"] = src.GetLastReadDate()
+	req.CollapseKey = readCollapseKey(peer)
+	// background sync: don't wake the device
+	req.Priority = "normal"
+
+	return nil
+}
+
 func serAlertingPushGcm(req *gcm.Request, data map[string]interface{}, src *api.AlertingPush) error {
 
 	if err := setNotificationPropsGcm(req, src); err != nil {
@@ -0,0 +1,16 @@
+package metric
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type CertificateExpiry struct {
+	left prometheus.Gauge
+}
+
+// Set sets the time left until the certificate expires
+func (c *CertificateExpiry) Set(left time.Duration) {
+	c.left.Set(left.Seconds())
+}
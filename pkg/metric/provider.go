@@ -5,9 +5,11 @@ import (
 )
 
 type Provider struct {
-	success prometheus.Counter
-	fails   prometheus.Counter
-	io      prometheus.Observer
+	success  prometheus.Counter
+	fails    prometheus.Counter
+	rejected prometheus.Counter
+	breaker  prometheus.Gauge
+	io       prometheus.Observer
 }
 
 func (p *Provider) SuccessInc() {
@@ -18,6 +20,15 @@ func (p *Provider) FailsInc() {
 	p.fails.Inc()
 }
 
+func (p *Provider) RejectedInc() {
+	p.rejected.Inc()
+}
+
+// SetBreakerState sets the state of the circuit breaker: 0 - closed, 1 - open, 2 - half-open
+func (p *Provider) SetBreakerState(state int) {
+	p.breaker.Set(float64(state))
+}
+
 func (p *Provider) NewIOTimer() (cancel func()) {
 	timer := prometheus.NewTimer(p.io)
 	cancel = func() {
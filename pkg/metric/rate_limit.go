@@ -0,0 +1,12 @@
+package metric
+
+import "github.com/prometheus/client_golang/prometheus"
+
+type RateLimit struct {
+	rate prometheus.Gauge
+}
+
+// Set sets the current rate limit: messages per second
+func (r *RateLimit) Set(rate float64) {
+	r.rate.Set(rate)
+}
@@ -0,0 +1,23 @@
+package metric
+
+import "github.com/prometheus/client_golang/prometheus"
+
+type Retry struct {
+	depth       prometheus.Gauge
+	age         prometheus.Gauge
+	deadLetters prometheus.Counter
+}
+
+// SetDepth sets count of notifications in the retry queue
+func (r *Retry) SetDepth(count int) {
+	r.depth.Set(float64(count))
+}
+
+// SetAge sets age (in seconds) of the oldest notification in the retry queue
+func (r *Retry) SetAge(seconds float64) {
+	r.age.Set(seconds)
+}
+
+func (r *Retry) DeadLettersInc() {
+	r.deadLetters.Inc()
+}
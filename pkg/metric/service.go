@@ -3,11 +3,20 @@ package metric
 import "github.com/prometheus/client_golang/prometheus"
 
 type Service struct {
-	success *prometheus.CounterVec
-	fails   *prometheus.CounterVec
-	io      *prometheus.HistogramVec
+	success   *prometheus.CounterVec
+	fails     *prometheus.CounterVec
+	rejected  *prometheus.CounterVec
+	rateLimit *prometheus.GaugeVec
+	breaker   *prometheus.GaugeVec
+	io        *prometheus.HistogramVec
+
+	certificateExpiry *prometheus.GaugeVec
 
 	pushesRecv *prometheus.CounterVec
+
+	retryDepth       prometheus.Gauge
+	retryAge         prometheus.Gauge
+	retryDeadLetters prometheus.Counter
 }
 
 func New() *Service {
@@ -23,6 +32,26 @@ func New() *Service {
 			Name:      "failed_tasks",
 			Help:      "Failed tasks"},
 			[]string{"kind", "projectId"}),
+		rejected: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "push",
+			Name:      "rejected_tasks",
+			Help:      "Tasks rejected by worker: the worker queue is full"},
+			[]string{"kind", "projectId"}),
+		rateLimit: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "push",
+			Name:      "rate_limit",
+			Help:      "Current rate limit of the provider requests (messages per second)"},
+			[]string{"kind", "projectId"}),
+		breaker: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "push",
+			Name:      "circuit_breaker_state",
+			Help:      "State of the provider circuit breaker: 0 - closed, 1 - open, 2 - half-open"},
+			[]string{"kind", "projectId"}),
+		certificateExpiry: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "push",
+			Name:      "certificate_expiry_seconds",
+			Help:      "Time left until the provider certificate expires (negative if the certificate has expired)"},
+			[]string{"kind", "projectId"}),
 		io: prometheus.NewHistogramVec(prometheus.HistogramOpts{
 			Namespace: "push",
 			Name:      "io",
@@ -32,14 +61,33 @@ func New() *Service {
 			Namespace: "push",
 			Name:      "pushes_recv",
 			Help:      "Pushes recv"},
-			[]string{"addr"}),
+			[]string{"addr", "client"}),
+		retryDepth: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: "push",
+			Name:      "retry_queue_depth",
+			Help:      "Notifications in the retry queue"}),
+		retryAge: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: "push",
+			Name:      "retry_queue_age_seconds",
+			Help:      "Age of the oldest notification in the retry queue"}),
+		retryDeadLetters: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: "push",
+			Name:      "retry_dead_letters",
+			Help:      "Notifications moved to the dead-letter store"}),
 	}
 
 	for _, c := range []prometheus.Collector{
 		m.success,
 		m.fails,
+		m.rejected,
+		m.rateLimit,
+		m.breaker,
+		m.certificateExpiry,
 		m.io,
 		m.pushesRecv,
+		m.retryDepth,
+		m.retryAge,
+		m.retryDeadLetters,
 	} {
 		if err := prometheus.Register(c); err != nil {
 			switch err.(type) {
@@ -69,6 +117,16 @@ func (m *Service) GetProviderMetrics(kind, projectId string) (*Provider, error)
 		return nil, err
 	}
 
+	p.rejected, err = m.rejected.GetMetricWith(prometheus.Labels{"kind": kind, "projectId": projectId})
+	if err != nil {
+		return nil, err
+	}
+
+	p.breaker, err = m.breaker.GetMetricWith(prometheus.Labels{"kind": kind, "projectId": projectId})
+	if err != nil {
+		return nil, err
+	}
+
 	p.io, err = m.io.GetMetricWith(prometheus.Labels{"kind": kind})
 	if err != nil {
 		return nil, err
@@ -77,12 +135,56 @@ func (m *Service) GetProviderMetrics(kind, projectId string) (*Provider, error)
 	return p, nil
 }
 
-func (m *Service) GetPeerMetrics(addr string) (*Peer, error) {
+// GetRateLimitMetric returns the rate limit gauge of the project.
+// The gauge is exported only by the projects with the rate limit
+func (m *Service) GetRateLimitMetric(kind, projectId string) (*RateLimit, error) {
 
-	pushRecv, err := m.pushesRecv.GetMetricWith(prometheus.Labels{"addr": addr})
+	rate, err := m.rateLimit.GetMetricWith(prometheus.Labels{"kind": kind, "projectId": projectId})
+	if err != nil {
+		return nil, err
+	}
+
+	return &RateLimit{rate: rate}, nil
+}
+
+// GetCertificateExpiryMetric returns the certificate expiry gauge of the project.
+// The gauge is exported only by the projects with a certificate
+func (m *Service) GetCertificateExpiryMetric(kind, projectId string) (*CertificateExpiry, error) {
+
+	left, err := m.certificateExpiry.GetMetricWith(prometheus.Labels{"kind": kind, "projectId": projectId})
+	if err != nil {
+		return nil, err
+	}
+
+	return &CertificateExpiry{left: left}, nil
+}
+
+// DeleteProviderMetrics deletes the gauges of the project: the worker of the project isn't used
+func (m *Service) DeleteProviderMetrics(kind, projectId string) {
+
+	for _, gauge := range []*prometheus.GaugeVec{
+		m.rateLimit,
+		m.breaker,
+		m.certificateExpiry,
+	} {
+		gauge.DeleteLabelValues(kind, projectId)
+	}
+}
+
+func (m *Service) GetPeerMetrics(addr, client string) (*Peer, error) {
+
+	pushRecv, err := m.pushesRecv.GetMetricWith(prometheus.Labels{"addr": addr, "client": client})
 	if err != nil {
 		return nil, err
 	}
 
 	return &Peer{pushRecv: pushRecv}, nil
 }
+
+func (m *Service) GetRetryMetrics() *Retry {
+	return &Retry{
+		depth:       m.retryDepth,
+		age:         m.retryAge,
+		deadLetters: m.retryDeadLetters,
+	}
+}
@@ -0,0 +1,53 @@
+package metric
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteProviderMetrics(t *testing.T) {
+
+	m := New()
+
+	for _, projectID := range []string{"p-1", "p-2"} {
+		p, err := m.GetProviderMetrics("apns", projectID)
+		require.NoError(t, err)
+
+		p.SetBreakerState(1)
+
+		rateLimit, err := m.GetRateLimitMetric("apns", projectID)
+		require.NoError(t, err)
+
+		rateLimit.Set(10)
+
+		expiry, err := m.GetCertificateExpiryMetric("apns", projectID)
+		require.NoError(t, err)
+
+		expiry.Set(time.Hour)
+	}
+
+	m.DeleteProviderMetrics("apns", "p-1")
+
+	// the gauges of the other projects aren't deleted
+	for _, gauge := range []interface {
+		DeleteLabelValues(...string) bool
+	}{m.rateLimit, m.breaker, m.certificateExpiry} {
+		require.False(t, gauge.DeleteLabelValues("apns", "p-1"))
+		require.True(t, gauge.DeleteLabelValues("apns", "p-2"))
+	}
+}
+
+func TestGetProviderMetrics(t *testing.T) {
+
+	m := New()
+
+	_, err := m.GetProviderMetrics("fcm", "p-3")
+	require.NoError(t, err)
+
+	// the rate limit isn't exported by the projects without the rate limit
+	require.False(t, m.rateLimit.DeleteLabelValues("fcm", "p-3"))
+	// the certificate expiry isn't exported by the projects without a certificate
+	require.False(t, m.certificateExpiry.DeleteLabelValues("fcm", "p-3"))
+}
@@ -1,13 +1,12 @@
 package ans
 
 import (
-	"bytes"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/asn1"
 	"fmt"
-	"io"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 )
@@ -20,6 +19,8 @@ var (
 	OidPushProduction = asn1.ObjectIdentifier([]int{1, 2, 840, 113635, 100, 6, 3, 2})
 	OidVoIPTopics     = asn1.ObjectIdentifier([]int{1, 2, 840, 113635, 100, 6, 3, 6})
 	OidVoIP           = asn1.ObjectIdentifier([]int{1, 2, 840, 113635, 100, 6, 3, 5})
+	// OidUID is the user ID attribute of the subject: the topic of the certificate
+	OidUID = asn1.ObjectIdentifier([]int{0, 9, 2342, 19200300, 100, 1, 1})
 )
 
 func ExistOID(cert *tls.Certificate, oid asn1.ObjectIdentifier) (bool, error) {
@@ -54,101 +55,213 @@ func GetOIDValue(cert *tls.Certificate, oid asn1.ObjectIdentifier) ([][]byte, er
 	return retval, nil
 }
 
-// GetTopics returns topics from certificate extension value
-// Binary data format:
-// <block start=0x30> <block size=0xD> <value start=0xc> <value size=0x2> <value byte 1> <value byte 2>
-// <block start=0x30> <block size=0x3> <value start=0xc> <value size=0x1> <value byte 1>
-// <value start=0xc> <value size=0x2> <value byte 1> <value byte 2>
-func GetTopics(src []byte) ([]string, error) {
-
-	type State int
-	const (
-		BlockStart uint8 = 0x30
-		ValueStart uint8 = 0xc
-
-		NextBlock State = iota
-		ReadBlockSize
-		ReadValueSize
-		ReadValue
-	)
-
-	var (
-		r         = bytes.NewReader(src)
-		value     = bytes.NewBuffer(nil)
-		state     = NextBlock
-		retval    = make([]string, 0)
-		valueSize byte
-	)
-
-	for {
-		b, err := r.ReadByte()
-		if err == io.EOF {
-			break
-		} else if err != nil {
-			return nil, err
-		}
+// GetNotAfter returns the expiry time of the client certificate (the first certificate of the chain)
+func GetNotAfter(cert *tls.Certificate) (time.Time, error) {
 
-		switch state {
-		case NextBlock:
-			if b == BlockStart {
-				state = ReadBlockSize
-			} else if b == ValueStart {
-				state = ReadValueSize
-			} else {
-				return nil, fmt.Errorf("topic: unknown block ID: %v", b)
-			}
+	leaf, err := getLeaf(cert)
+	if err != nil {
+		return time.Time{}, err
+	}
 
-		case ReadBlockSize:
-			state = NextBlock
+	return leaf.NotAfter, nil
+}
 
-		case ReadValueSize:
-			valueSize = b
-			state = ReadValue
+// GetSubjectTopic returns the subject UID of the client certificate. Empty if the subject hasn't UID
+func GetSubjectTopic(cert *tls.Certificate) (string, error) {
 
-		case ReadValue:
-			value.WriteByte(b)
-			valueSize--
+	leaf, err := getLeaf(cert)
+	if err != nil {
+		return "", err
+	}
 
-			if valueSize == 0 {
-				retval = append(retval, value.String())
-				value.Reset()
-				state = NextBlock
+	for _, name := range leaf.Subject.Names {
+		if name.Type.Equal(OidUID) {
+			if value, ok := name.Value.(string); ok {
+				return value, nil
 			}
 		}
 	}
 
-	return retval, nil
+	return "", nil
 }
 
-func CheckVoIPTopicByCert(topic string, cert *tls.Certificate) error {
+// getLeaf returns the client certificate: the first certificate of the chain
+func getLeaf(cert *tls.Certificate) (*x509.Certificate, error) {
+
+	if cert.Leaf != nil {
+		return cert.Leaf, nil
+	}
+
+	if len(cert.Certificate) == 0 {
+		return nil, errors.New("empty certificate")
+	}
+
+	return x509.ParseCertificate(cert.Certificate[0])
+}
+
+// topic kinds of the certificate topics (OidVoIPTopics)
+const (
+	TopicKindApp          = "app"
+	TopicKindVoIP         = "voip"
+	TopicKindComplication = "complication"
+)
+
+// Topic is a topic of the certificate
+type Topic struct {
+	Name string
+	// Kind is one of TopicKindApp, TopicKindVoIP, TopicKindComplication
+	Kind string
+}
+
+// GetCertificateTopics returns topics from the certificate extension OidVoIPTopics.
+// If the certificate has no extension, the topic is the subject UID. The result is
+// empty if the certificate has neither the extension nor the subject UID
+func GetCertificateTopics(cert *tls.Certificate) ([]Topic, error) {
 
 	oidValues, err := GetOIDValue(cert, OidVoIPTopics)
 	if err != nil {
-		return errors.Wrap(err, "read VoIP topics")
+		return nil, errors.Wrap(err, "read topics")
 	}
 
-	topicList := make([]string, 0, 10)
+	retval := make([]Topic, 0)
 	for _, value := range oidValues {
-		list, err := GetTopics(value)
+		list, err := parseTopics(value)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
-		topicList = append(topicList, list...)
+		retval = append(retval, list...)
 	}
 
-	// check config topic by certificate
-	if topic != "" {
-		var exist bool
-		for i := range topicList {
-			if strings.Compare(topicList[i], topic) == 0 {
-				exist = true
-				break
-			}
+	if len(oidValues) == 0 {
+		uid, err := GetSubjectTopic(cert)
+		if err != nil {
+			return nil, errors.Wrap(err, "read subject")
+		}
+
+		if uid != "" {
+			retval = append(retval, Topic{Name: uid, Kind: topicKindByName(uid)})
+		}
+	}
+
+	return retval, nil
+}
+
+// parseTopics parses the extension value:
+// SEQUENCE { UTF8String <topic>, SEQUENCE { UTF8String <topic kind> }, ... }
+func parseTopics(src []byte) ([]Topic, error) {
+
+	var block asn1.RawValue
+	if _, err := asn1.Unmarshal(src, &block); err != nil {
+		return nil, errors.Wrap(err, "topics")
+	}
+
+	if !block.IsCompound {
+		return nil, errors.New("topics: invalid block")
+	}
+
+	retval := make([]Topic, 0)
+	for rest := block.Bytes; len(rest) > 0; {
+		var (
+			value asn1.RawValue
+			err   error
+		)
+
+		rest, err = asn1.Unmarshal(rest, &value)
+		if err != nil {
+			return nil, errors.Wrap(err, "topics")
+		}
+
+		if !value.IsCompound {
+			retval = append(retval, Topic{
+				Name: string(value.Bytes),
+				Kind: topicKindByName(string(value.Bytes)),
+			})
+			continue
+		}
+
+		// the kind of the previous topic
+		if len(retval) == 0 {
+			return nil, errors.New("topics: kind without topic")
 		}
 
-		if !exist {
-			return fmt.Errorf("invalid VoIP topic: '%s' (topics in certificate: %v)", topic, topicList)
+		var kind asn1.RawValue
+		if _, err := asn1.Unmarshal(value.Bytes, &kind); err != nil {
+			return nil, errors.Wrap(err, "topic kind")
+		}
+
+		retval[len(retval)-1].Kind = string(kind.Bytes)
+	}
+
+	return retval, nil
+}
+
+func topicKindByName(name string) string {
+
+	switch {
+	case strings.HasSuffix(name, "."+TopicKindVoIP):
+		return TopicKindVoIP
+	case strings.HasSuffix(name, "."+TopicKindComplication):
+		return TopicKindComplication
+	}
+
+	return TopicKindApp
+}
+
+// DefaultTopic returns the topic of the certificate for the notifications: the first
+// app topic. The VoIP notifications are sent to the VoIP variant of the topic (see VoIPTopic)
+func DefaultTopic(topics []Topic) string {
+
+	for _, topic := range topics {
+		if topic.Kind == TopicKindApp {
+			return topic.Name
+		}
+	}
+
+	if len(topics) > 0 {
+		return topics[0].Name
+	}
+
+	return ""
+}
+
+// VoIPTopic returns the topic of the VoIP notifications: `<bundle ID>.voip`
+func VoIPTopic(topic string) string {
+
+	if topic == "" || strings.HasSuffix(topic, "."+TopicKindVoIP) {
+		return topic
+	}
+
+	return topic + "." + TopicKindVoIP
+}
+
+// CheckTopic returns an error if the topic (the bundle ID or the .voip, .complication variant)
+// isn't listed in the certificate topics or is a VoIP topic of a non-VoIP certificate.
+// If the certificate supports VoIP (the project sends VoIP pushes) and lists the VoIP topics,
+// the VoIP variant of an app topic must be listed too
+func CheckTopic(topic string, topics []Topic, supportsVoIP bool) error {
+
+	kinds := make(map[string]string, len(topics))
+	names := make([]string, 0, len(topics))
+	hasVoIP := false
+	for _, t := range topics {
+		kinds[t.Name] = t.Kind
+		names = append(names, t.Name)
+		hasVoIP = hasVoIP || t.Kind == TopicKindVoIP
+	}
+
+	kind, ok := kinds[topic]
+	if !ok {
+		return fmt.Errorf("invalid topic: '%s' (topics in certificate: %v)", topic, names)
+	}
+
+	if kind == TopicKindVoIP && !supportsVoIP {
+		return fmt.Errorf("VoIP topic '%s' on a non-VoIP certificate", topic)
+	}
+
+	if kind == TopicKindApp && supportsVoIP && hasVoIP {
+		if voipTopic := VoIPTopic(topic); kinds[voipTopic] != TopicKindVoIP {
+			return fmt.Errorf("invalid VoIP topic: '%s' (topics in certificate: %v)", voipTopic, names)
 		}
 	}
 
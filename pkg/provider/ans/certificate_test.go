@@ -1,37 +1,88 @@
 package ans
 
 import (
+	"crypto/tls"
 	"testing"
 
+	"github.com/dialogs/dialog-push-service/pkg/test"
 	"github.com/stretchr/testify/require"
 )
 
-func TestGetTopics(t *testing.T) {
+func TestGetCertificateTopics(t *testing.T) {
 
-	{
-		topics, err := GetTopics([]byte{
-			0x30, 0x18, 0xc, 0x1, 'a',
-			0x30, 0x2, 0xc, 0x2, 'b', 'c',
-			0xc, 0x01, 'd',
-			0x30, 0x3, 0xc, 0x3, 'e', 'f', 'g',
-			0xc, 0x03, 'h', 'i', 'j'})
+	getTopics := func(cfg test.AppleCertificate) []Topic {
+		t.Helper()
+
+		pem, err := test.NewAppleCertificatePem(cfg)
 		require.NoError(t, err)
-		require.Equal(t, []string{"a", "bc", "d", "efg", "hij"}, topics)
-	}
 
-	{
-		topics, err := GetTopics([]byte{
-			0x30, 0x3, 0xc, 0x1, 'a'})
+		cert, err := tls.X509KeyPair(pem, pem)
 		require.NoError(t, err)
-		require.Equal(t, []string{"a"}, topics)
-	}
 
-	{
-		topics, err := GetTopics([]byte{
-			0x30, 0x8, 0xc, 0x1, 'a',
-			0xc, 0x03, 'h', 'i', 'j'})
+		topics, err := GetCertificateTopics(&cert)
 		require.NoError(t, err)
-		require.Equal(t, []string{"a", "hij"}, topics)
+
+		return topics
+	}
+
+	// the long topic names: the lengths of the blocks are in the long form
+	bundleID := "im.dlg.dialog-enterprise-edition.application-with-a-very-long-bundle-identifier"
+
+	topics := getTopics(test.AppleCertificate{
+		Production: true,
+		Topics:     []string{bundleID, bundleID + ".voip", bundleID + ".complication"},
+	})
+	require.Equal(t,
+		[]Topic{
+			{Name: bundleID, Kind: TopicKindApp},
+			{Name: bundleID + ".voip", Kind: TopicKindVoIP},
+			{Name: bundleID + ".complication", Kind: TopicKindComplication},
+		},
+		topics)
+
+	require.Equal(t, bundleID, DefaultTopic(topics))
+	require.Equal(t, bundleID+".voip", VoIPTopic(DefaultTopic(topics)))
+	require.Equal(t, bundleID+".voip", VoIPTopic(bundleID+".voip"))
+
+	// the certificate without the topics extension: the topic is the subject UID
+	topics = getTopics(test.AppleCertificate{Production: true, UID: bundleID})
+	require.Equal(t, []Topic{{Name: bundleID, Kind: TopicKindApp}}, topics)
+	require.Equal(t, bundleID, DefaultTopic(topics))
+
+	require.Empty(t, getTopics(test.AppleCertificate{Production: true}))
+	require.Empty(t, DefaultTopic(nil))
+	require.Empty(t, VoIPTopic(""))
+}
+
+func TestCheckTopic(t *testing.T) {
+
+	topics := []Topic{
+		{Name: "im.dlg.test", Kind: TopicKindApp},
+		{Name: "im.dlg.test.voip", Kind: TopicKindVoIP},
 	}
 
+	require.NoError(t, CheckTopic("im.dlg.test", topics, false))
+	require.NoError(t, CheckTopic("im.dlg.test.voip", topics, true))
+
+	require.EqualError(t,
+		CheckTopic("im.dlg.test.voip", topics, false),
+		"VoIP topic 'im.dlg.test.voip' on a non-VoIP certificate")
+
+	require.EqualError(t,
+		CheckTopic("im.dlg.test.complication", topics, true),
+		"invalid topic: 'im.dlg.test.complication' (topics in certificate: [im.dlg.test im.dlg.test.voip])")
+
+	// the VoIP variant isn't required for the complication topic
+	topics = append(topics, Topic{Name: "im.dlg.test.complication", Kind: TopicKindComplication})
+	require.NoError(t, CheckTopic("im.dlg.test.complication", topics, true))
+
+	// the VoIP variant of the app topic is required only if the certificate supports VoIP
+	topics = append(topics, Topic{Name: "im.dlg.other", Kind: TopicKindApp})
+	require.NoError(t, CheckTopic("im.dlg.other", topics, false))
+	require.EqualError(t,
+		CheckTopic("im.dlg.other", topics, true),
+		"invalid VoIP topic: 'im.dlg.other.voip' (topics in certificate: [im.dlg.test im.dlg.test.voip im.dlg.test.complication im.dlg.other])")
+
+	// the certificate without the topics extension (the subject UID topic)
+	require.NoError(t, CheckTopic("im.dlg.test", []Topic{{Name: "im.dlg.test", Kind: TopicKindApp}}, true))
 }
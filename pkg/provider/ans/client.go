@@ -4,12 +4,14 @@ import (
 	"bytes"
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"io"
 	"io/ioutil"
 	"net"
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/dialogs/dialog-push-service/pkg/provider"
@@ -17,16 +19,31 @@ import (
 	"golang.org/x/net/http2"
 )
 
+const (
+	EndpointProduction  = "https://api.push.apple.com"
+	EndpointDevelopment = "https://api.development.push.apple.com"
+)
+
 type Client struct {
 	client         *http.Client
 	endpointPrefix string
 	certTLS        tls.Certificate
 	sandbox        bool
-	retries        int
+	retryPolicy    *provider.RetryPolicy
 	supportsVoIP   bool
+	// notAfter is the expiry time of the certificate
+	notAfter time.Time
+
+	// provider authentication token, if the client uses token-based connection:
+	// https://developer.apple.com/documentation/usernotifications/setting_up_a_remote_notification_server/establishing_a_token-based_connection_to_apns
+	token *Token
 }
 
-func New(certTLS *tls.Certificate, isSandbox bool, retries int, timeout time.Duration) (*Client, error) {
+// New returns a client with certificate-based connection to APNs.
+// If endpoint is empty, the client sends notifications to the production or
+// development APNs server by the certificate and sandbox mode.
+// If rootCAs is nil, the client uses the host's root CA set
+func New(certTLS *tls.Certificate, isSandbox bool, retryPolicy *provider.RetryPolicy, timeout time.Duration, endpoint string, rootCAs *x509.CertPool) (*Client, error) {
 
 	hasDevelopCert, err := ExistOID(certTLS, OidPushDevelop)
 	if err != nil {
@@ -43,44 +60,82 @@ func New(certTLS *tls.Certificate, isSandbox bool, retries int, timeout time.Dur
 		return nil, errors.Wrap(err, "failed to read certificate property 'supports VoIP'")
 	}
 
+	notAfter, err := GetNotAfter(certTLS)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read certificate expiry")
+	}
+
 	sandbox := hasDevelopCert && (!hasProductionCert || isSandbox)
 
-	endpointPrefix := "https://api.push.apple.com"
-	if sandbox {
-		endpointPrefix = "https://api.development.push.apple.com"
+	c := newClient(certTLS, sandbox, retryPolicy, timeout, endpoint, rootCAs)
+	c.certTLS = *certTLS
+	c.supportsVoIP = supportsVoIP
+	c.notAfter = notAfter
+
+	return c, nil
+}
+
+// NewFromToken returns a client with token-based connection to APNs.
+// One signing key can be used for all topics of the team, so VoIP support
+// is defined by the project settings instead of a certificate
+func NewFromToken(token *Token, supportsVoIP, isSandbox bool, retryPolicy *provider.RetryPolicy, timeout time.Duration, endpoint string, rootCAs *x509.CertPool) (*Client, error) {
+
+	if token == nil {
+		return nil, errors.New("empty provider token")
+	}
+
+	if _, err := token.Bearer(); err != nil {
+		return nil, err
+	}
+
+	c := newClient(nil, isSandbox, retryPolicy, timeout, endpoint, rootCAs)
+	c.token = token
+	c.supportsVoIP = supportsVoIP
+
+	return c, nil
+}
+
+func newClient(certTLS *tls.Certificate, sandbox bool, retryPolicy *provider.RetryPolicy, timeout time.Duration, endpoint string, rootCAs *x509.CertPool) *Client {
+
+	if endpoint == "" {
+		endpoint = EndpointProduction
+		if sandbox {
+			endpoint = EndpointDevelopment
+		}
 	}
-	endpointPrefix += "/3/device/"
+	endpointPrefix := strings.TrimSuffix(endpoint, "/") + "/3/device/"
 
 	if timeout <= 0 {
 		timeout = time.Second * 10
 	}
 
-	client := newHttpClient(certTLS, timeout)
-
 	return &Client{
-		client:         client,
+		client:         newHttpClient(certTLS, rootCAs, timeout),
 		endpointPrefix: endpointPrefix,
-		certTLS:        *certTLS,
 		sandbox:        sandbox,
-		retries:        retries,
-		supportsVoIP:   supportsVoIP,
-	}, nil
+		retryPolicy:    retryPolicy,
+	}
 }
 
-func NewFromPem(pemData []byte, isSandbox bool, retries int, timeout time.Duration) (*Client, error) {
+func NewFromPem(pemData []byte, isSandbox bool, retryPolicy *provider.RetryPolicy, timeout time.Duration, endpoint string, rootCAs *x509.CertPool) (*Client, error) {
 
 	certTLS, err := tls.X509KeyPair(pemData, pemData)
 	if err != nil {
 		return nil, errors.Wrap(err, "read certificate")
 	}
 
-	return New(&certTLS, isSandbox, retries, timeout)
+	return New(&certTLS, isSandbox, retryPolicy, timeout, endpoint, rootCAs)
 }
 
 func (c *Client) Certificate() tls.Certificate {
 	return c.certTLS
 }
 
+// Close closes the idle connections of the client
+func (c *Client) Close() {
+	c.client.CloseIdleConnections()
+}
+
 func (c *Client) Sandbox() bool {
 	return c.sandbox
 }
@@ -89,6 +144,18 @@ func (c *Client) SupportsVoIP() bool {
 	return c.supportsVoIP
 }
 
+// NotAfter returns the expiry time of the certificate. Zero if the client uses
+// token-based connection
+func (c *Client) NotAfter() time.Time {
+	return c.notAfter
+}
+
+// Token returns the provider authentication token or nil if the client uses
+// certificate-based connection
+func (c *Client) Token() *Token {
+	return c.token
+}
+
 func (c *Client) Send(ctx context.Context, message *Request) (retval *Response, err error) {
 
 	req, err := c.newRequest(ctx, message.Token, &message.Headers)
@@ -96,16 +163,16 @@ func (c *Client) Send(ctx context.Context, message *Request) (retval *Response,
 		return nil, err
 	}
 
-	fnSend := func() (statusCode int, _ error) {
+	fnSend := func() (statusCode int, retryAfter time.Duration, _ error) {
 		retval, err = c.send(ctx, req, message)
 		if err != nil {
-			return 0, err
+			return 0, 0, err
 		}
 
-		return retval.StatusCode, err
+		return retval.StatusCode, retval.RetryAfter, err
 	}
 
-	err = provider.SendWithRetry(c.retries, fnSend)
+	err = provider.SendWithRetry(ctx, c.retryPolicy, fnSend)
 	if err != nil {
 		return nil, err
 	}
@@ -118,6 +185,15 @@ func (c *Client) send(ctx context.Context, req *http.Request, message *Request)
 	body := ioutil.NopCloser(bytes.NewReader(message.Payload))
 	req.Body = body
 
+	if c.token != nil {
+		bearer, err := c.token.Bearer()
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("authorization", "bearer "+bearer)
+	}
+
 	res, err := c.client.Do(req)
 	if err != nil {
 		if urlError, ok := err.(*url.Error); ok {
@@ -132,6 +208,7 @@ func (c *Client) send(ctx context.Context, req *http.Request, message *Request)
 	defer res.Body.Close()
 
 	resp := NewResponse(res.Header.Get("apns-id"), res.StatusCode)
+	resp.RetryAfter = provider.ParseRetryAfter(res.Header, time.Now())
 	switch resp.StatusCode {
 	case 200, 400, 403, 404, 405, 410, 413, 429, 500, 503:
 		// Table 8-6Values for the APNs JSON reason key
@@ -145,6 +222,11 @@ func (c *Client) send(ctx context.Context, req *http.Request, message *Request)
 		}
 	}
 
+	if c.token != nil && resp.StatusCode == http.StatusForbidden && resp.Body.Reason == ReasonExpiredProviderToken {
+		// the next request is sent with a new token
+		c.token.Reset()
+	}
+
 	return resp, nil
 }
 
@@ -180,12 +262,16 @@ func (c *Client) newRequest(ctx context.Context, token string, header *RequestHe
 		req.Header.Set("apns-collapse-id", header.CollapseID)
 	}
 
+	if header.PushType != "" {
+		req.Header.Set("apns-push-type", header.PushType)
+	}
+
 	req = req.WithContext(ctx)
 
 	return req, nil
 }
 
-func newHttpClient(certTLS *tls.Certificate, timeout time.Duration) *http.Client {
+func newHttpClient(certTLS *tls.Certificate, rootCAs *x509.CertPool, timeout time.Duration) *http.Client {
 
 	dial := func(network, addr string, cfg *tls.Config) (net.Conn, error) {
 		dialer := &net.Dialer{
@@ -196,10 +282,13 @@ func newHttpClient(certTLS *tls.Certificate, timeout time.Duration) *http.Client
 	}
 
 	tlsConfig := &tls.Config{
-		Certificates: []tls.Certificate{*certTLS},
+		RootCAs: rootCAs,
 	}
-	if len(certTLS.Certificate) > 0 {
-		tlsConfig.BuildNameToCertificate()
+	if certTLS != nil {
+		tlsConfig.Certificates = []tls.Certificate{*certTLS}
+		if len(certTLS.Certificate) > 0 {
+			tlsConfig.BuildNameToCertificate()
+		}
 	}
 
 	transport := &http2.Transport{
@@ -6,26 +6,21 @@ import (
 	"testing"
 	"time"
 
+	"github.com/dialogs/dialog-push-service/pkg/provider"
 	"github.com/dialogs/dialog-push-service/pkg/test"
 	"github.com/stretchr/testify/require"
 )
 
-// Environment for tests:
-// 1. download certificate PEM
-// 2. create environment variable "APPLE_PUSH_CERTIFICATE" with path to certificate
-// 3. create file with devices tokens. format:
-//	{
-//     "android": "<token>",
-//     "ios": "<token>"
-//	}
-// 4. create environment variable "PUSH_DEVICES" with path to file with devices tokens
-
 func TestSendOk(t *testing.T) {
 
-	client := getClient(t)
+	srv := test.NewAPNsServer()
+	defer srv.Close()
+
+	client := getClient(t, srv)
 
 	req := &Request{
-		Token:   getDeviceToken(t),
+		Token:   "token1",
+		Headers: RequestHeader{Topic: "im.dlg.test", Priority: 10, PushType: PushTypeAlert},
 		Payload: getPayload(t),
 	}
 
@@ -33,44 +28,167 @@ func TestSendOk(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, res.ID, 36) // example: CDB997A0-0C7C-8E2E-DBB5-13E89D5C756E
 
-	var ms int64
-	if ts := res.Body.GetTimestamp(); !ts.IsZero() {
-		ms = ts.UnixNano() / int64(time.Millisecond)
-	}
-
 	require.Equal(t,
 		&Response{
 			ID:         res.ID,
 			StatusCode: 200,
 			Body: ResponseBody{
 				Reason:    "",
-				Timestamp: ms,
+				Timestamp: 0,
 			},
 		},
 		res)
+
+	requests := srv.Requests()
+	require.Len(t, requests, 1)
+	require.Equal(t, "/3/device/token1", requests[0].Path)
+	require.Equal(t, "im.dlg.test", requests[0].Header.Get("apns-topic"))
+	require.Equal(t, "10", requests[0].Header.Get("apns-priority"))
+	require.Equal(t, "alert", requests[0].Header.Get("apns-push-type"))
+	require.Empty(t, requests[0].Header.Get("authorization"))
+	require.JSONEq(t, string(req.Payload), string(requests[0].Body))
 }
 
 func TestSendError(t *testing.T) {
 
-	client := getClient(t)
+	srv := test.NewAPNsServer()
+	defer srv.Close()
+
+	srv.SetReply("bad-token", test.ReplyBadDeviceToken)
+	srv.SetReply("old-token", test.ReplyUnregistered)
+	srv.SetReply("limited-token", test.ReplyTooManyRequests)
+
+	client := getClient(t, srv)
+
+	for _, testInfo := range []struct {
+		Token      string
+		StatusCode int
+		Reason     string
+	}{
+		{"", 400, "MissingDeviceToken"},
+		{"bad-token", 400, ReasonBadDeviceToken},
+		{"old-token", 410, "Unregistered"},
+		{"limited-token", 429, "TooManyRequests"},
+	} {
+		req := &Request{
+			Token:   testInfo.Token,
+			Payload: getPayload(t),
+		}
+
+		res, err := client.Send(context.Background(), req)
+		require.NoError(t, err, testInfo.Token)
+		require.Len(t, res.ID, 36)
+		require.Equal(t, testInfo.StatusCode, res.StatusCode, testInfo.Token)
+		require.Equal(t, testInfo.Reason, res.Body.Reason, testInfo.Token)
+
+		if testInfo.StatusCode == 410 {
+			require.WithinDuration(t, time.Now(), res.Body.GetTimestamp(), time.Minute)
+		} else {
+			require.Zero(t, res.Body.Timestamp)
+		}
+	}
+}
+
+func TestSendRetries(t *testing.T) {
+
+	srv := test.NewAPNsServer()
+	defer srv.Close()
+
+	srv.SetReply("token1", test.ReplyInternalError)
+	srv.SetReply("token2", test.ReplyMalformed)
+
+	client := getClient(t, srv)
+
+	{
+		res, err := client.Send(context.Background(), &Request{Token: "token1", Payload: getPayload(t)})
+		require.Equal(t, provider.ErrInternalServerError, err)
+		require.Nil(t, res)
+		require.Len(t, srv.Requests(), 2)
+	}
+
+	{
+		res, err := client.Send(context.Background(), &Request{Token: "token2", Payload: getPayload(t)})
+		require.EqualError(t, err, `invalid asn response: source: {"token":"*","headers":{"expiration":"*"},"payload":{"aps":{"alert":{"body":"*","title":"*"}}}}: unexpected EOF`)
+		require.Nil(t, res)
+	}
+}
+
+func TestSendWithToken(t *testing.T) {
+
+	srv := test.NewAPNsServer()
+	defer srv.Close()
+
+	key, err := test.NewAuthKeyPem()
+	require.NoError(t, err)
+
+	token, err := NewToken(key, "key-id", "team-id")
+	require.NoError(t, err)
+
+	client, err := NewFromToken(token, true, false, provider.NewRetryPolicy(2), time.Second, srv.URL, test.CertPool(srv.Server))
+	require.NoError(t, err)
+	require.True(t, client.SupportsVoIP())
+	require.Equal(t, token, client.Token())
 
 	req := &Request{
+		Token:   "token1",
+		Headers: RequestHeader{Topic: "im.dlg.test"},
 		Payload: getPayload(t),
 	}
+
 	res, err := client.Send(context.Background(), req)
 	require.NoError(t, err)
-	require.Len(t, res.ID, 36) // example: CDB997A0-0C7C-8E2E-DBB5-13E89D5C756E
+	require.Equal(t, 200, res.StatusCode)
 
-	require.Equal(t,
-		&Response{
-			ID:         res.ID,
-			StatusCode: 400,
-			Body: ResponseBody{
-				Reason:    "MissingDeviceToken",
-				Timestamp: 0,
-			},
-		},
-		res)
+	bearer, err := token.Bearer()
+	require.NoError(t, err)
+
+	requests := srv.Requests()
+	require.Len(t, requests, 1)
+	require.Equal(t, "bearer "+bearer, requests[0].Header.Get("authorization"))
+	require.Equal(t, "im.dlg.test", requests[0].Header.Get("apns-topic"))
+}
+
+func TestNewFromPem(t *testing.T) {
+
+	for _, testInfo := range []struct {
+		Cert      test.AppleCertificate
+		IsSandbox bool
+		Sandbox   bool
+		VoIP      bool
+	}{
+		{test.AppleCertificate{Production: true}, false, false, false},
+		{test.AppleCertificate{Production: true}, true, false, false},
+		{test.AppleCertificate{Develop: true}, false, true, false},
+		{test.AppleCertificate{Develop: true, Production: true}, false, false, false},
+		{test.AppleCertificate{Develop: true, Production: true}, true, true, false},
+		{test.AppleCertificate{Production: true, VoIP: true}, false, false, true},
+	} {
+		pem, err := test.NewAppleCertificatePem(testInfo.Cert)
+		require.NoError(t, err)
+
+		client, err := NewFromPem(pem, testInfo.IsSandbox, nil, 0, "", nil)
+		require.NoError(t, err)
+		require.Equal(t, testInfo.Sandbox, client.Sandbox(), "%+v", testInfo)
+		require.Equal(t, testInfo.VoIP, client.SupportsVoIP(), "%+v", testInfo)
+		require.Nil(t, client.Token())
+
+		expected := EndpointProduction + "/3/device/"
+		if testInfo.Sandbox {
+			expected = EndpointDevelopment + "/3/device/"
+		}
+		require.Equal(t, expected, client.endpointPrefix)
+	}
+
+	notAfter := time.Now().Add(48 * time.Hour).Truncate(time.Second)
+	pem, err := test.NewAppleCertificatePem(test.AppleCertificate{Production: true, NotAfter: notAfter})
+	require.NoError(t, err)
+
+	client, err := NewFromPem(pem, false, nil, 0, "", nil)
+	require.NoError(t, err)
+	require.True(t, notAfter.Equal(client.NotAfter()), client.NotAfter())
+
+	_, err = NewFromPem([]byte("pem"), false, nil, 0, "", nil)
+	require.EqualError(t, err, "read certificate: tls: failed to find any PEM data in certificate input")
 }
 
 func getPayload(t *testing.T) []byte {
@@ -90,30 +208,17 @@ func getPayload(t *testing.T) []byte {
 	return jPayload
 }
 
-func getClient(t *testing.T) *Client {
-	t.Helper()
-
-	pem := getCertificatePem(t)
-	client, err := NewFromPem(pem, false, 2, 0)
-	require.NoError(t, err)
-
-	return client
-}
-
-func getCertificatePem(t *testing.T) []byte {
+func getClient(t *testing.T, srv *test.APNsServer) *Client {
 	t.Helper()
 
-	pem, err := test.GetIOSCertificatePem()
+	pem, err := test.NewAppleCertificatePem(test.AppleCertificate{
+		Production: true,
+		Topics:     []string{"im.dlg.test"},
+	})
 	require.NoError(t, err)
 
-	return pem
-}
-
-func getDeviceToken(t *testing.T) string {
-	t.Helper()
-
-	_, token, err := test.GetPushDevices()
+	client, err := NewFromPem(pem, false, provider.NewRetryPolicy(2), 0, srv.URL, test.CertPool(srv.Server))
 	require.NoError(t, err)
 
-	return token
+	return client
 }
@@ -4,6 +4,16 @@ import (
 	"encoding/json"
 	"net/url"
 	"time"
+
+	"github.com/dialogs/dialog-push-service/pkg/provider"
+)
+
+// Values of apns-push-type header:
+// https://developer.apple.com/documentation/usernotifications/setting_up_a_remote_notification_server/sending_notification_requests_to_apns
+const (
+	PushTypeAlert      = "alert"
+	PushTypeBackground = "background"
+	PushTypeVoIP       = "voip"
 )
 
 // RequestHeader format:
@@ -18,6 +28,7 @@ type RequestHeader struct {
 	Priority   int       `json:"priority,omitempty"`
 	Topic      string    `json:"topic,omitempty"`
 	CollapseID string    `json:"collapse-id,omitempty"`
+	PushType   string    `json:"push-type,omitempty"`
 }
 
 type Request struct {
@@ -35,6 +46,22 @@ func (r *Request) SetToken(token string) {
 	}
 }
 
+func (r *Request) SetExpiration(expiration time.Time) {
+	if r != nil {
+		r.Headers.Expiration = expiration
+	}
+}
+
 func (r *Request) ShouldIgnore() bool {
 	return r == nil
 }
+
+func (r *Request) Clone() provider.IRequest {
+
+	if r == nil {
+		return r
+	}
+
+	retval := *r
+	return &retval
+}
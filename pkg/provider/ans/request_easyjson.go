@@ -48,6 +48,8 @@ func easyjson3c9d2b01DecodeGithubComDialogsDialogPushServicePkgProviderAns(in *j
 			out.Topic = string(in.String())
 		case "collapse-id":
 			out.CollapseID = string(in.String())
+		case "push-type":
+			out.PushType = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -108,6 +110,16 @@ func easyjson3c9d2b01EncodeGithubComDialogsDialogPushServicePkgProviderAns(out *
 		}
 		out.String(string(in.CollapseID))
 	}
+	if in.PushType != "" {
+		const prefix string = ",\"push-type\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.PushType))
+	}
 	out.RawByte('}')
 }
 
@@ -4,6 +4,14 @@ import (
 	"time"
 )
 
+// Reasons of an error
+// Table 8-6 Values for the APNs JSON reason key
+// https://developer.apple.com/library/archive/documentation/NetworkingInternet/Conceptual/RemoteNotificationsPG/CommunicatingwithAPNs.html#//apple_ref/doc/uid/TP40008194-CH11-SW1
+const (
+	ReasonBadDeviceToken       = "BadDeviceToken"
+	ReasonExpiredProviderToken = "ExpiredProviderToken"
+)
+
 // Response format
 // Table 8-3 APNs response headers, Table 8-5 APNs JSON data keys -
 // https://developer.apple.com/library/archive/documentation/NetworkingInternet/Conceptual/RemoteNotificationsPG/CommunicatingwithAPNs.html#//apple_ref/doc/uid/TP40008194-CH11-SW1
@@ -11,6 +19,8 @@ type Response struct {
 	ID         string       `json:"id"`
 	StatusCode int          `json:"status_code"`
 	Body       ResponseBody `json:"body"`
+	// RetryAfter is the delay of the Retry-After header
+	RetryAfter time.Duration `json:"-"`
 }
 
 // Table 8-5APNs JSON data keys
@@ -0,0 +1,123 @@
+package ans
+
+import (
+	"crypto/ecdsa"
+	"crypto/x509"
+	"encoding/pem"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/pkg/errors"
+)
+
+// TokenRefreshInterval is a lifetime of the provider authentication token.
+// APNs rejects tokens older than one hour and tokens that are refreshed more
+// often than once every 20 minutes:
+// https://developer.apple.com/documentation/usernotifications/setting_up_a_remote_notification_server/establishing_a_token-based_connection_to_apns
+const TokenRefreshInterval = 50 * time.Minute
+
+var (
+	ErrAuthKeyNotPem   = errors.New("auth key: invalid pem data")
+	ErrAuthKeyNotECDSA = errors.New("auth key: key is not ECDSA")
+)
+
+// Token is a provider authentication token (JWT) signed by the signing key (.p8)
+type Token struct {
+	key    *ecdsa.PrivateKey
+	keyID  string
+	teamID string
+
+	mu       sync.Mutex
+	bearer   string
+	issuedAt time.Time
+}
+
+func NewToken(keyData []byte, keyID, teamID string) (*Token, error) {
+
+	if keyID == "" {
+		return nil, errors.New("token: empty key ID")
+	}
+
+	if teamID == "" {
+		return nil, errors.New("token: empty team ID")
+	}
+
+	key, err := AuthKeyFromPem(keyData)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Token{
+		key:    key,
+		keyID:  keyID,
+		teamID: teamID,
+	}, nil
+}
+
+// AuthKeyFromPem reads the signing key in PKCS#8 format (.p8 file)
+func AuthKeyFromPem(keyData []byte) (*ecdsa.PrivateKey, error) {
+
+	block, _ := pem.Decode(keyData)
+	if block == nil {
+		return nil, ErrAuthKeyNotPem
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "auth key")
+	}
+
+	ecdsaKey, ok := key.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, ErrAuthKeyNotECDSA
+	}
+
+	return ecdsaKey, nil
+}
+
+func (t *Token) KeyID() string {
+	return t.keyID
+}
+
+func (t *Token) TeamID() string {
+	return t.teamID
+}
+
+// Bearer returns the cached token or signs a new one if the cached token is expired
+func (t *Token) Bearer() (string, error) {
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now()
+	if t.bearer != "" && now.Sub(t.issuedAt) < TokenRefreshInterval {
+		return t.bearer, nil
+	}
+
+	// token format:
+	// https://developer.apple.com/documentation/usernotifications/setting_up_a_remote_notification_server/establishing_a_token-based_connection_to_apns
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{
+		"iss": t.teamID,
+		"iat": now.Unix(),
+	})
+	token.Header["kid"] = t.keyID
+
+	bearer, err := token.SignedString(t.key)
+	if err != nil {
+		return "", errors.Wrap(err, "sign token")
+	}
+
+	t.bearer = bearer
+	t.issuedAt = now
+
+	return bearer, nil
+}
+
+// Reset drops the cached token. The next call of Bearer signs a new token
+func (t *Token) Reset() {
+	t.mu.Lock()
+	t.bearer = ""
+	t.issuedAt = time.Time{}
+	t.mu.Unlock()
+}
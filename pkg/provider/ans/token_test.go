@@ -0,0 +1,93 @@
+package ans
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewToken(t *testing.T) {
+
+	key := newAuthKey(t)
+
+	_, err := NewToken(key, "", "team-id")
+	require.EqualError(t, err, "token: empty key ID")
+
+	_, err = NewToken(key, "key-id", "")
+	require.EqualError(t, err, "token: empty team ID")
+
+	_, err = NewToken([]byte("key"), "key-id", "team-id")
+	require.Equal(t, ErrAuthKeyNotPem, err)
+
+	token, err := NewToken(key, "key-id", "team-id")
+	require.NoError(t, err)
+	require.Equal(t, "key-id", token.KeyID())
+	require.Equal(t, "team-id", token.TeamID())
+}
+
+func TestTokenBearer(t *testing.T) {
+
+	token, err := NewToken(newAuthKey(t), "key-id", "team-id")
+	require.NoError(t, err)
+
+	bearer, err := token.Bearer()
+	require.NoError(t, err)
+
+	parsed, err := jwt.Parse(bearer, func(*jwt.Token) (interface{}, error) {
+		return &token.key.PublicKey, nil
+	})
+	require.NoError(t, err)
+	require.True(t, parsed.Valid)
+	require.Equal(t, jwt.SigningMethodES256, parsed.Method)
+	require.Equal(t, "key-id", parsed.Header["kid"])
+
+	claims := parsed.Claims.(jwt.MapClaims)
+	require.Equal(t, "team-id", claims["iss"])
+	require.InDelta(t, time.Now().Unix(), claims["iat"], 5)
+
+	{
+		// test: cached token
+		cached, err := token.Bearer()
+		require.NoError(t, err)
+		require.Equal(t, bearer, cached)
+	}
+
+	{
+		// test: expired token
+		token.issuedAt = time.Now().Add(-TokenRefreshInterval)
+
+		refreshed, err := token.Bearer()
+		require.NoError(t, err)
+		require.NotEqual(t, bearer, refreshed)
+		require.WithinDuration(t, time.Now(), token.issuedAt, time.Second)
+	}
+
+	{
+		// test: reset token
+		token.Reset()
+		require.Empty(t, token.bearer)
+
+		_, err := token.Bearer()
+		require.NoError(t, err)
+		require.NotEmpty(t, token.bearer)
+	}
+}
+
+func newAuthKey(t *testing.T) []byte {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
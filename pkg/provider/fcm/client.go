@@ -2,10 +2,12 @@ package fcm
 
 import (
 	"context"
+	"crypto/x509"
 	"encoding/json"
 	"io"
 	"net/http"
 	"net/url"
+	"strings"
 	"sync/atomic"
 	"time"
 
@@ -28,6 +30,8 @@ import (
 // 1. copy server key from: https://console.firebase.google.com/project/_/settings/cloudmessaging/
 // 2. add to request header: Authorization:key=<server key>
 
+const DefaultEndpoint = "https://fcm.googleapis.com"
+
 type Client struct {
 	client *http.Client
 
@@ -35,8 +39,8 @@ type Client struct {
 	// https://firebase.google.com/docs/reference/fcm/rest/v1/projects.messages/send
 	endpoint string
 
-	// count send retries
-	retries int
+	// policy of send retries
+	retryPolicy *provider.RetryPolicy
 
 	// oauth token
 	token atomic.Value
@@ -48,7 +52,10 @@ type Client struct {
 	sandbox bool
 }
 
-func New(serviceAccount []byte, isSandbox bool, retries int, timeout time.Duration) (*Client, error) {
+// New returns FCM client.
+// The endpoint is a base URL of FCM API (DefaultEndpoint if it is empty), the tokenEndpoint
+// overrides OAuth token URL from the service account, the rootCAs overrides the host's root CA set
+func New(serviceAccount []byte, isSandbox bool, retryPolicy *provider.RetryPolicy, timeout time.Duration, endpoint, tokenEndpoint string, rootCAs *x509.CertPool) (*Client, error) {
 
 	scope := []string{
 		// To authorize access to FCM, request:
@@ -61,6 +68,10 @@ func New(serviceAccount []byte, isSandbox bool, retries int, timeout time.Durati
 		return nil, errors.Wrap(err, "jwt config")
 	}
 
+	if tokenEndpoint != "" {
+		jwtConfig.TokenURL = tokenEndpoint
+	}
+
 	account := &struct {
 		ProjectID string `json:"project_id"`
 	}{}
@@ -73,17 +84,27 @@ func New(serviceAccount []byte, isSandbox bool, retries int, timeout time.Durati
 		timeout = time.Second * 10
 	}
 
+	if endpoint == "" {
+		endpoint = DefaultEndpoint
+	}
+
 	return &Client{
-		endpoint:  getEndpoint(account.ProjectID),
-		retries:   retries,
-		jwtConfig: jwtConfig,
-		sandbox:   isSandbox,
+		endpoint:    getEndpoint(endpoint, account.ProjectID),
+		retryPolicy: retryPolicy,
+		jwtConfig:   jwtConfig,
+		sandbox:     isSandbox,
 		client: &http.Client{
-			Timeout: timeout,
+			Timeout:   timeout,
+			Transport: provider.NewHTTPTransport(rootCAs),
 		},
 	}, nil
 }
 
+// Close closes the idle connections of the client
+func (c *Client) Close() {
+	c.client.CloseIdleConnections()
+}
+
 func (c *Client) Sandbox() bool {
 	return c.sandbox
 }
@@ -100,17 +121,17 @@ func (c *Client) Send(ctx context.Context, message *Message) (retval *Response,
 		return nil, err
 	}
 
-	fnSend := func() (statusCode int, _ error) {
+	fnSend := func() (statusCode int, retryAfter time.Duration, _ error) {
 		var e error
 		retval, e = c.send(ctx, req, payload)
 		if e != nil {
-			return 0, e
+			return 0, 0, e
 		}
 
-		return retval.StatusCode, nil
+		return retval.StatusCode, retval.RetryAfter, nil
 	}
 
-	err = provider.SendWithRetry(c.retries, fnSend)
+	err = provider.SendWithRetry(ctx, c.retryPolicy, fnSend)
 	if err != nil {
 		return nil, err
 	}
@@ -151,6 +172,7 @@ func (c *Client) send(ctx context.Context, req *http.Request, message json.RawMe
 
 	retval := &Response{
 		StatusCode: res.StatusCode,
+		RetryAfter: provider.ParseRetryAfter(res.Header, time.Now()),
 	}
 
 	// https://firebase.google.com/docs/reference/fcm/rest/v1/ErrorCode
@@ -192,6 +214,9 @@ func (c *Client) getToken(ctx context.Context) (*oauth2.Token, error) {
 		}
 	}
 
+	// the token is requested with the same transport settings as notifications
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, c.client)
+
 	// source:
 	// https://github.com/googleapis/google-api-go-client/blob/0c3fc9a1ae141ce9db158d15b06bca77ddcb923b/google-api-go-generator/gen.go#L613
 	token, err := c.jwtConfig.TokenSource(ctx).Token()
@@ -204,8 +229,8 @@ func (c *Client) getToken(ctx context.Context) (*oauth2.Token, error) {
 	return token, nil
 }
 
-func getEndpoint(projectID string) string {
+func getEndpoint(endpoint, projectID string) string {
 
 	projectID = url.PathEscape(projectID)
-	return "https://fcm.googleapis.com/v1/projects/" + projectID + "/messages:send"
+	return strings.TrimSuffix(endpoint, "/") + "/v1/projects/" + projectID + "/messages:send"
 }
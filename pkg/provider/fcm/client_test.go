@@ -8,20 +8,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/dialogs/dialog-push-service/pkg/provider"
 	"github.com/dialogs/dialog-push-service/pkg/test"
 	"github.com/stretchr/testify/require"
 )
 
-// Environment for tests:
-// 1. download service-account.json from https://console.firebase.google.com/project/_/settings/serviceaccounts/adminsdk
-// 2. create environment variable "GOOGLE_APPLICATION_CREDENTIALS" with path to service-account.json
-// 3. create file with devices tokens. format:
-//	{
-//     "android": "<token>",
-//     "ios": "<token>"
-//	}
-// 4. create environment variable "PUSH_DEVICES" with path to file with devices tokens
-
 func init() {
 	log.SetFlags(log.Llongfile | log.Ltime | log.Lmicroseconds)
 }
@@ -30,26 +21,31 @@ func TestGetEndpoint(t *testing.T) {
 
 	require.Equal(t,
 		"https://fcm.googleapis.com/v1/projects/project-id/messages:send",
-		getEndpoint("project-id"))
+		getEndpoint(DefaultEndpoint, "project-id"))
 
 	require.Equal(t,
 		"https://fcm.googleapis.com/v1/projects/project%20%2F%5C/messages:send",
-		getEndpoint(`project /\`))
+		getEndpoint(DefaultEndpoint, `project /\`))
+
+	require.Equal(t,
+		"https://127.0.0.1:8080/v1/projects/project-id/messages:send",
+		getEndpoint("https://127.0.0.1:8080/", "project-id"))
 }
 
 func TestSendOk(t *testing.T) {
 
-	token := getDeviceToken(t)
+	srv := test.NewFCMServer("project-id")
+	defer srv.Close()
 
 	msg := &Message{
-		Token: token,
+		Token: "token1",
 		Notification: &Notification{
 			Title: "test-title",
 			Body:  time.Now().Format(time.RFC3339Nano),
 		},
 	}
 
-	client := getClient(t)
+	client := getClient(t, srv)
 
 	for _, sandbox := range []bool{false, true} {
 		client.sandbox = sandbox
@@ -59,74 +55,133 @@ func TestSendOk(t *testing.T) {
 			resp, err := client.Send(context.Background(), msg)
 			require.NoError(t, err)
 			require.True(t, resp.Ok())
-			require.True(t, strings.HasPrefix(resp.Name, "projects/"), resp.Name)
+			require.True(t, strings.HasPrefix(resp.Name, "projects/project-id/messages/"), resp.Name)
+		}
+	}
+
+	require.Equal(t, 1, srv.CountTokens())
+
+	requests := srv.Requests()
+	require.Len(t, requests, 6)
+	for i, req := range requests {
+		require.Equal(t, "/v1/projects/project-id/messages:send", req.Path)
+		require.Equal(t, "Bearer fake-access-token-1", req.Header.Get("Authorization"))
+
+		validateOnly := ""
+		if i >= 3 {
+			validateOnly = `"validate_only":true,`
 		}
+		require.JSONEq(t,
+			`{`+validateOnly+`"message":{"token":"token1","notification":{"title":"test-title","body":"`+msg.Notification.Body+`"}}}`,
+			string(req.Body))
 	}
 }
 
 func TestSendError(t *testing.T) {
 
-	msg := &Message{
-		Token: "-",
+	srv := test.NewFCMServer("project-id")
+	defer srv.Close()
+
+	srv.SetReply("-", test.ReplyBadDeviceToken)
+	srv.SetReply("token1", test.ReplyUnregistered)
+	srv.SetReply("token2", test.ReplyTooManyRequests)
+
+	client := getClient(t, srv)
+
+	{
+		resp, err := client.Send(context.Background(), &Message{Token: "-"})
+		require.NoError(t, err)
+		require.Falsef(t, resp.Ok(), "%#v", resp)
+
+		require.Equal(t,
+			&Response{
+				StatusCode: 400,
+				Error: &SendError{
+					Code:    400,
+					Message: `The registration token is not a valid FCM registration token`,
+					Status:  "INVALID_ARGUMENT",
+					Details: json.RawMessage([]byte(`[{"@type":"type.googleapis.com/google.firebase.fcm.v1.FcmError","errorCode":"INVALID_ARGUMENT"},{"@type":"type.googleapis.com/google.rpc.BadRequest","fieldViolations":[{"description":"The registration token is not a valid FCM registration token","field":"message.token"}]}]`)),
+				},
+			},
+			resp)
 	}
 
-	client := getClient(t)
-	resp, err := client.Send(context.Background(), msg)
-	require.NoError(t, err)
-	require.Falsef(t, resp.Ok(), "%#v", resp)
+	{
+		resp, err := client.Send(context.Background(), &Message{Token: "token1"})
+		require.NoError(t, err)
+		require.Equal(t, 404, resp.StatusCode)
+		require.Equal(t, "NOT_FOUND", string(resp.Error.Status))
+		require.JSONEq(t,
+			`[{"@type":"type.googleapis.com/google.firebase.fcm.v1.FcmError","errorCode":"UNREGISTERED"}]`,
+			string(resp.Error.Details))
+	}
 
-	require.Equal(t,
-		&Response{
-			StatusCode: 400,
-			Error: &SendError{
-				Code:    400,
-				Message: `The registration token is not a valid FCM registration token`,
-				Status:  "INVALID_ARGUMENT",
-				Details: json.RawMessage([]byte(`[
-      {
-        "@type": "type.googleapis.com/google.firebase.fcm.v1.FcmError",
-        "errorCode": "INVALID_ARGUMENT"
-      },
-      {
-        "@type": "type.googleapis.com/google.rpc.BadRequest",
-        "fieldViolations": [
-          {
-            "field": "message.token",
-            "description": "The registration token is not a valid FCM registration token"
-          }
-        ]
-      }
-    ]`)),
-			},
-		},
-		resp)
+	{
+		resp, err := client.Send(context.Background(), &Message{Token: "token2"})
+		require.NoError(t, err)
+		require.Equal(t, 429, resp.StatusCode)
+		require.Equal(t, "RESOURCE_EXHAUSTED", string(resp.Error.Status))
+	}
 }
 
-func getClient(t *testing.T) *Client {
-	t.Helper()
+func TestSendRetries(t *testing.T) {
+
+	srv := test.NewFCMServer("project-id")
+	defer srv.Close()
+
+	srv.SetReply("token1", test.ReplyInternalError)
+	srv.SetReply("token2", test.ReplyMalformed)
+
+	client := getClient(t, srv)
+
+	{
+		resp, err := client.Send(context.Background(), &Message{Token: "token1"})
+		require.Equal(t, provider.ErrInternalServerError, err)
+		require.Nil(t, resp)
+		require.Len(t, srv.Requests(), 2)
+	}
+
+	{
+		resp, err := client.Send(context.Background(), &Message{Token: "token2"})
+		require.EqualError(t, err, `invalid fcm response: source: {"token":"*"}: unexpected EOF`)
+		require.Nil(t, resp)
+	}
+}
+
+func TestSendInvalidServiceAccount(t *testing.T) {
 
-	svcAccount := getServiceAccount(t)
+	srv := test.NewFCMServer("project-id")
+	defer srv.Close()
 
-	client, err := New(svcAccount, false, 2, time.Second)
+	svcAccount := getServiceAccount(t, srv)
+
+	// the token is requested from the server with the unknown certificate
+	client, err := New(svcAccount, false, nil, time.Second, srv.URL, "", nil)
 	require.NoError(t, err)
 
-	return client
+	resp, err := client.Send(context.Background(), &Message{Token: "token1"})
+	require.Error(t, err)
+	require.Nil(t, resp)
+	require.Empty(t, srv.Requests())
+	require.Equal(t, 0, srv.CountTokens())
 }
 
-func getServiceAccount(t *testing.T) []byte {
+func getClient(t *testing.T, srv *test.FCMServer) *Client {
 	t.Helper()
 
-	data, err := test.GetGoogleServiceAccount()
+	svcAccount := getServiceAccount(t, srv)
+
+	client, err := New(svcAccount, false, provider.NewRetryPolicy(2), time.Second, srv.URL, "", test.CertPool(srv.Server))
 	require.NoError(t, err)
 
-	return data
+	return client
 }
 
-func getDeviceToken(t *testing.T) string {
+func getServiceAccount(t *testing.T, srv *test.FCMServer) []byte {
 	t.Helper()
 
-	token, _, err := test.GetPushDevices()
+	data, err := srv.ServiceAccount()
 	require.NoError(t, err)
 
-	return token
+	return data
 }
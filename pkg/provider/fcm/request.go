@@ -1,6 +1,10 @@
 package fcm
 
-import "encoding/json"
+import (
+	"encoding/json"
+
+	"github.com/dialogs/dialog-push-service/pkg/provider"
+)
 
 const (
 	AndroidMessagePriorityNormal AndroidMessagePriority = "NORMAL"
@@ -116,6 +120,16 @@ func (m *Message) ShouldIgnore() bool {
 	return m == nil
 }
 
+func (m *Message) Clone() provider.IRequest {
+
+	if m == nil {
+		return m
+	}
+
+	retval := *m
+	return &retval
+}
+
 type Request struct {
 	ValidateOnly bool            `json:"validate_only,omitempty"`
 	Message      json.RawMessage `json:"message"`
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"strconv"
 	"strings"
+	"time"
 )
 
 const (
@@ -12,6 +13,7 @@ const (
 	ErrorCodeInternal        ErrorCode = "INTERNAL"
 	ErrorCodeUnspecified     ErrorCode = "UNSPECIFIED_ERROR"
 	ErrorCodeInvalidArgument ErrorCode = "INVALID_ARGUMENT"
+	ErrorCodeQuotaExceeded   ErrorCode = "QUOTA_EXCEEDED"
 )
 
 // ErrorCode values
@@ -46,6 +48,8 @@ type Response struct {
 	Name       string     `json:"name,omitempty"`
 	StatusCode int        `json:"-"`
 	Error      *SendError `json:"error,omitempty"`
+	// RetryAfter is the delay of the Retry-After header
+	RetryAfter time.Duration `json:"-"`
 }
 
 // Ok returns true if notification success send
@@ -94,3 +98,23 @@ func (e SendError) Error() string {
 
 	return b.String()
 }
+
+// FcmErrorCode returns the error code from the details (google.firebase.fcm.v1.FcmError).
+// If the details don't contain the error code, the method returns the error status
+func (e SendError) FcmErrorCode() ErrorCode {
+
+	details := make([]struct {
+		Type      string    `json:"@type"`
+		ErrorCode ErrorCode `json:"errorCode"`
+	}, 0)
+
+	if err := json.Unmarshal(e.Details, &details); err == nil {
+		for _, item := range details {
+			if item.Type == "type.googleapis.com/google.firebase.fcm.v1.FcmError" && item.ErrorCode != "" {
+				return item.ErrorCode
+			}
+		}
+	}
+
+	return e.Status
+}
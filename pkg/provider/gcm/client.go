@@ -2,16 +2,22 @@ package gcm
 
 import (
 	"context"
+	"crypto/x509"
 	"encoding/json"
 	"io"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/dialogs/dialog-push-service/pkg/provider"
 	"github.com/pkg/errors"
 )
 
-const ErrorCodeFailedToReadResponse = "FailedToReadResponse"
+const (
+	ErrorCodeFailedToReadResponse = "FailedToReadResponse"
+
+	DefaultEndpoint = "https://fcm.googleapis.com"
+)
 
 // Client (legacy/gcm)
 // https://firebase.google.com/docs/cloud-messaging/http-server-ref
@@ -21,8 +27,12 @@ const ErrorCodeFailedToReadResponse = "FailedToReadResponse"
 type Client struct {
 	client *http.Client
 
-	// count send retries
-	retries int
+	// send message endpoint:
+	// https://firebase.google.com/docs/cloud-messaging/http-server-ref#downstream-http-messages-json
+	endpoint string
+
+	// policy of send retries
+	retryPolicy *provider.RetryPolicy
 
 	// authorization key:
 	// https://firebase.google.com/docs/cloud-messaging/migrate-v1#before_2
@@ -31,22 +41,36 @@ type Client struct {
 	sandbox bool
 }
 
-func New(key []byte, isSandbox bool, retries int, timeout time.Duration) (*Client, error) {
+// New returns legacy FCM client.
+// The endpoint is a base URL of FCM API (DefaultEndpoint if it is empty),
+// the rootCAs overrides the host's root CA set
+func New(key []byte, isSandbox bool, retryPolicy *provider.RetryPolicy, timeout time.Duration, endpoint string, rootCAs *x509.CertPool) (*Client, error) {
 
 	if timeout <= 0 {
 		timeout = time.Second * 10
 	}
 
+	if endpoint == "" {
+		endpoint = DefaultEndpoint
+	}
+
 	return &Client{
+		endpoint:            strings.TrimSuffix(endpoint, "/") + "/fcm/send",
 		headerAuthorization: "key=" + string(key),
-		retries:             retries,
+		retryPolicy:         retryPolicy,
 		sandbox:             isSandbox,
 		client: &http.Client{
-			Timeout: timeout,
+			Timeout:   timeout,
+			Transport: provider.NewHTTPTransport(rootCAs),
 		},
 	}, nil
 }
 
+// Close closes the idle connections of the client
+func (c *Client) Close() {
+	c.client.CloseIdleConnections()
+}
+
 func (c *Client) Sandbox() bool {
 	return c.sandbox
 }
@@ -62,16 +86,16 @@ func (c *Client) Send(ctx context.Context, message *Request) (retval *Response,
 		message.DryRun = true
 	}
 
-	fnSend := func() (int, error) {
+	fnSend := func() (int, time.Duration, error) {
 		retval, err = c.send(ctx, req, message)
 		if err != nil {
-			return 0, err
+			return 0, 0, err
 		}
 
-		return retval.StatusCode, err
+		return retval.StatusCode, retval.RetryAfter, err
 	}
 
-	err = provider.SendWithRetry(c.retries, fnSend)
+	err = provider.SendWithRetry(ctx, c.retryPolicy, fnSend)
 	if err != nil {
 		return nil, err
 	}
@@ -98,6 +122,7 @@ func (c *Client) send(ctx context.Context, req *http.Request, message *Request)
 
 	retval := &Response{
 		StatusCode: res.StatusCode,
+		RetryAfter: provider.ParseRetryAfter(res.Header, time.Now()),
 	}
 
 	// https://firebase.google.com/docs/cloud-messaging/http-server-ref#error-codes
@@ -116,7 +141,7 @@ func (c *Client) send(ctx context.Context, req *http.Request, message *Request)
 
 func (c *Client) newRequest(ctx context.Context) (*http.Request, error) {
 
-	req, err := http.NewRequest(http.MethodPost, "https://fcm.googleapis.com/fcm/send", nil)
+	req, err := http.NewRequest(http.MethodPost, c.endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2,36 +2,25 @@ package gcm
 
 import (
 	"context"
+	"net/url"
 	"testing"
 	"time"
 
+	"github.com/dialogs/dialog-push-service/pkg/provider"
 	"github.com/dialogs/dialog-push-service/pkg/test"
 	"github.com/stretchr/testify/require"
 )
 
-// Environment for tests:
-// 1. copy server key from https://console.firebase.google.com/project/_/settings/cloudmessaging/android:com.example.push
-// 2. save server key in file. File format:
-//	{
-//		"key":"<server key>"
-//	}
-// 2. create environment variable "GOOGLE_LEGACY_APPLICATION_CREDENTIALS" with path to server key file
-// 3. create file with devices tokens. format:
-//	{
-//     "android": "<token>",
-//     "ios": "<token>"
-//	}
-// 4. create environment variable "PUSH_DEVICES" with path to file with devices tokens
-
 func TestSendOk(t *testing.T) {
 
-	token := getDeviceToken(t)
+	srv := test.NewGCMServer("server-key")
+	defer srv.Close()
 
 	req := &Request{
-		To: token,
+		To: "token1",
 	}
 
-	client := getClient(t)
+	client := getClient(t, srv)
 	resp, err := client.Send(context.Background(), req)
 	require.NoError(t, err)
 
@@ -53,40 +42,76 @@ func TestSendOk(t *testing.T) {
 			},
 		},
 		resp)
+
+	requests := srv.Requests()
+	require.Len(t, requests, 1)
+	require.Equal(t, "/fcm/send", requests[0].Path)
+	require.Equal(t, "key=server-key", requests[0].Header.Get("Authorization"))
+	require.JSONEq(t, `{"to":"token1"}`, string(requests[0].Body))
 }
 
 func TestSendError(t *testing.T) {
 
-	req := &Request{
-		To: "",
+	srv := test.NewGCMServer("server-key")
+	defer srv.Close()
+
+	srv.SetReply("token1", test.ReplyBadDeviceToken)
+	srv.SetReply("token2", test.ReplyUnregistered)
+
+	client := getClient(t, srv)
+
+	for token, errorCode := range map[string]string{
+		"":       ErrorCodeMissingRegistration,
+		"token1": ErrorCodeInvalidRegistration,
+		"token2": "NotRegistered",
+	} {
+		resp, err := client.Send(context.Background(), &Request{To: token})
+		require.NoError(t, err)
+
+		require.True(t, resp.MulticastID > 0, resp.MulticastID)
+
+		require.Equal(t,
+			&Response{
+				MulticastID: resp.MulticastID,
+				Success:     0,
+				Failure:     1,
+				StatusCode:  200,
+				Results: []*ResponseResult{
+					{
+						MessageID:      "",
+						RegistrationID: "",
+						Error:          errorCode,
+					},
+				},
+			},
+			resp)
 	}
 
-	client := getClient(t)
-	resp, err := client.Send(context.Background(), req)
-	require.NoError(t, err)
+	{
+		srv.SetReply("token3", test.ReplyTooManyRequests)
 
-	require.True(t, resp.MulticastID > 0, resp.MulticastID)
+		resp, err := client.Send(context.Background(), &Request{To: "token3"})
+		require.NoError(t, err)
+		require.Equal(t, &Response{StatusCode: 429}, resp)
+	}
 
-	require.Equal(t,
-		&Response{
-			MulticastID: resp.MulticastID,
-			Success:     0,
-			Failure:     1,
-			StatusCode:  200,
-			Results: []*ResponseResult{
-				{
-					MessageID:      "",
-					RegistrationID: "",
-					Error:          ErrorCodeMissingRegistration,
-				},
-			},
-		},
-		resp)
+	{
+		srv.SetReply("token4", test.ReplyInternalError)
+
+		resp, err := client.Send(context.Background(), &Request{To: "token4"})
+		require.Equal(t, provider.ErrInternalServerError, err)
+		require.Nil(t, resp)
+	}
 }
 
 func TestInvalidRequestData(t *testing.T) {
 
-	client := getClient(t)
+	srv := test.NewGCMServer("server-key")
+	defer srv.Close()
+
+	srv.SetReply("token1", test.ReplyMalformed)
+
+	client := getClient(t, srv)
 
 	{
 		resp, err := client.Send(context.Background(), nil)
@@ -100,36 +125,36 @@ func TestInvalidRequestData(t *testing.T) {
 		}
 
 		resp, err := client.Send(context.Background(), msg)
-		require.EqualError(t, err, "Post https://fcm.googleapis.com/fcm/send: json: error calling MarshalJSON for type *gcm.Request: invalid character '}' after object key")
+		require.IsType(t, &url.Error{}, err)
+		require.Contains(t, err.Error(), "json: error calling MarshalJSON for type *gcm.Request: invalid character '}' after object key")
+		require.Nil(t, resp)
+	}
+
+	{
+		resp, err := client.Send(context.Background(), &Request{To: "token1"})
+		require.EqualError(t, err, `invalid gcm response: source: {"to":"*"}: unexpected EOF`)
 		require.Nil(t, resp)
 	}
 }
 
-func getClient(t *testing.T) *Client {
-	t.Helper()
+func TestInvalidKey(t *testing.T) {
 
-	key := getAccountKey(t)
+	srv := test.NewGCMServer("server-key")
+	defer srv.Close()
 
-	client, err := New(key, false, 2, time.Second)
+	client, err := New([]byte("invalid-key"), false, nil, time.Second, srv.URL, test.CertPool(srv.Server))
 	require.NoError(t, err)
 
-	return client
-}
-
-func getAccountKey(t *testing.T) []byte {
-	t.Helper()
-
-	key, err := test.GetAccountKey()
+	resp, err := client.Send(context.Background(), &Request{To: "token1"})
 	require.NoError(t, err)
-
-	return key
+	require.Equal(t, &Response{StatusCode: 401}, resp)
 }
 
-func getDeviceToken(t *testing.T) string {
+func getClient(t *testing.T, srv *test.GCMServer) *Client {
 	t.Helper()
 
-	token, _, err := test.GetPushDevices()
+	client, err := New([]byte("server-key"), false, provider.NewRetryPolicy(2), time.Second, srv.URL, test.CertPool(srv.Server))
 	require.NoError(t, err)
 
-	return token
+	return client
 }
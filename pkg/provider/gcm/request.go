@@ -1,6 +1,10 @@
 package gcm
 
-import "encoding/json"
+import (
+	"encoding/json"
+
+	"github.com/dialogs/dialog-push-service/pkg/provider"
+)
 
 // Table 2b:
 // https://firebase.google.com/docs/cloud-messaging/http-server-ref#notification-payload-support
@@ -45,3 +49,13 @@ func (r *Request) SetToken(token string) {
 func (r *Request) ShouldIgnore() bool {
 	return r == nil
 }
+
+func (r *Request) Clone() provider.IRequest {
+
+	if r == nil {
+		return r
+	}
+
+	retval := *r
+	return &retval
+}
@@ -1,9 +1,22 @@
 package gcm
 
+import "time"
+
+// Error codes:
+// https://firebase.google.com/docs/cloud-messaging/http-server-ref#error-codes
 const (
-	ErrorCodeMissingRegistration = "MissingRegistration"
-	ErrorCodeInvalidRegistration = "InvalidRegistration"
-	ErrorCodeUnavailable         = "Unavailable"
+	ErrorCodeMissingRegistration       = "MissingRegistration"
+	ErrorCodeInvalidRegistration       = "InvalidRegistration"
+	ErrorCodeNotRegistered             = "NotRegistered"
+	ErrorCodeUnavailable               = "Unavailable"
+	ErrorCodeInternalServerError       = "InternalServerError"
+	ErrorCodeMismatchSenderID          = "MismatchSenderId"
+	ErrorCodeInvalidPackageName        = "InvalidPackageName"
+	ErrorCodeMessageTooBig             = "MessageTooBig"
+	ErrorCodeInvalidDataKey            = "InvalidDataKey"
+	ErrorCodeInvalidTTL                = "InvalidTtl"
+	ErrorCodeDeviceMessageRateExceeded = "DeviceMessageRateExceeded"
+	ErrorCodeTopicsMessageRateExceeded = "TopicsMessageRateExceeded"
 )
 
 type Response struct {
@@ -12,6 +25,8 @@ type Response struct {
 	Failure     int               `json:"failure"`
 	StatusCode  int               `json:"-"`
 	Results     []*ResponseResult `json:"results"`
+	// RetryAfter is the delay of the Retry-After header
+	RetryAfter time.Duration `json:"-"`
 }
 
 type ResponseResult struct {
@@ -3,11 +3,15 @@ package provider
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"errors"
 	"io"
+	"net"
 	"net/http"
 	"regexp"
+	"time"
 )
 
 var (
@@ -15,40 +19,90 @@ var (
 	ErrServiceUnavailable  = errors.New("remote push server: service unavailable")
 )
 
-func SendWithRetry(maxRetries int, send func() (statusCode int, _ error)) error {
+// SendWithRetry sends the request by the retry policy. The send function returns
+// status code of the answer and the delay of the Retry-After header.
+// The request is sent again on timeouts and http statuses 429, 500, 503.
+// The answer with status 429 is returned to the caller after the last attempt
+func SendWithRetry(ctx context.Context, policy *RetryPolicy, send func() (statusCode int, retryAfter time.Duration, _ error)) error {
 
-	if maxRetries <= 0 {
-		maxRetries = 1
+	if policy == nil {
+		policy = &RetryPolicy{}
 	}
 
-	for attempt := 0; attempt < maxRetries; attempt++ {
-		hasAttempts := attempt < maxRetries-1
+	maxRetries := policy.maxRetries()
+	start := time.Now()
 
-		statusCode, err := send()
-		if err != nil {
-			if hasAttempts && err == context.DeadlineExceeded {
-				continue
-			}
-			return err
+	for attempt := 1; ; attempt++ {
+		statusCode, retryAfter, err := send()
 
-		} else if statusCode == http.StatusInternalServerError {
-			if hasAttempts {
-				continue
+		var retval error
+		switch {
+		case err != nil:
+			if ctx.Err() != nil || !isTimeout(err) {
+				return err
 			}
-			return ErrInternalServerError
+			retval = err
 
-		} else if statusCode == http.StatusServiceUnavailable {
-			if hasAttempts {
-				continue
-			}
-			return ErrServiceUnavailable
+		case statusCode == http.StatusInternalServerError:
+			retval = ErrInternalServerError
+
+		case statusCode == http.StatusServiceUnavailable:
+			retval = ErrServiceUnavailable
+
+		case statusCode == http.StatusTooManyRequests:
+			// the answer is returned to the caller
+
+		default:
+			return nil
+		}
+
+		if attempt >= maxRetries {
+			return retval
+		}
+
+		delay := policy.Delay(attempt)
+		if retryAfter > delay {
+			delay = retryAfter
+		}
+
+		if policy.MaxElapsed > 0 && time.Since(start)+delay > policy.MaxElapsed {
+			return retval
+		}
 
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
 		}
+	}
+}
+
+func isTimeout(err error) bool {
+
+	if err == context.DeadlineExceeded {
+		return true
+	}
+
+	netErr, ok := err.(net.Error)
+	return ok && netErr.Timeout()
+}
+
+// NewHTTPTransport returns a transport with the root certificate authorities.
+// If rootCAs is nil, the method returns nil: http.DefaultTransport is used
+func NewHTTPTransport(rootCAs *x509.CertPool) http.RoundTripper {
+
+	if rootCAs == nil {
+		return nil
+	}
 
-		break
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{
+		RootCAs: rootCAs,
 	}
 
-	return nil
+	return transport
 }
 
 // DecodeJSONResponse unmarshal response in json format to the object.
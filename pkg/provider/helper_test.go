@@ -6,75 +6,189 @@ import (
 	"errors"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
 
 func TestSendWithRetry(t *testing.T) {
 
+	ctx := context.Background()
+
+	send := func(statusCode int, err error) func() (int, time.Duration, error) {
+		return func() (int, time.Duration, error) { return statusCode, 0, err }
+	}
+
 	for maxRetries := 0; maxRetries < 4; maxRetries++ {
+		policy := &RetryPolicy{MaxRetries: maxRetries}
+
 		require.NoError(t,
-			SendWithRetry(maxRetries, func() (int, error) { return 0, nil }))
+			SendWithRetry(ctx, policy, send(0, nil)))
 
 		require.NoError(t,
-			SendWithRetry(maxRetries, func() (int, error) { return http.StatusOK, nil }))
+			SendWithRetry(ctx, policy, send(http.StatusOK, nil)))
 
 		require.NoError(t,
-			SendWithRetry(maxRetries, func() (int, error) { return http.StatusOK, nil }))
+			SendWithRetry(ctx, policy, send(http.StatusBadRequest, nil)))
 
 		require.NoError(t,
-			SendWithRetry(maxRetries, func() (int, error) { return http.StatusBadRequest, nil }))
+			SendWithRetry(ctx, policy, send(http.StatusTooManyRequests, nil)))
 
 		require.Equal(t,
 			ErrInternalServerError,
-			SendWithRetry(maxRetries, func() (int, error) { return http.StatusInternalServerError, nil }))
+			SendWithRetry(ctx, policy, send(http.StatusInternalServerError, nil)))
 
 		require.Equal(t,
 			ErrServiceUnavailable,
-			SendWithRetry(maxRetries, func() (int, error) { return http.StatusServiceUnavailable, nil }))
+			SendWithRetry(ctx, policy, send(http.StatusServiceUnavailable, nil)))
 
 		require.Equal(t,
 			context.DeadlineExceeded,
-			SendWithRetry(maxRetries, func() (int, error) { return http.StatusOK, context.DeadlineExceeded }))
+			SendWithRetry(ctx, policy, send(http.StatusOK, context.DeadlineExceeded)))
 	}
 
 	{
 		var counter int
 		require.Equal(t,
 			errors.New("test error"),
-			SendWithRetry(6, func() (int, error) {
+			SendWithRetry(ctx, &RetryPolicy{MaxRetries: 6}, func() (int, time.Duration, error) {
 				counter++
 				switch counter {
 				case 1:
-					return http.StatusInternalServerError, nil
+					return http.StatusInternalServerError, 0, nil
 				case 2:
-					return http.StatusServiceUnavailable, nil
+					return http.StatusServiceUnavailable, 0, nil
 				case 3:
-					return 0, context.DeadlineExceeded
+					return 0, 0, context.DeadlineExceeded
+				case 4:
+					return http.StatusTooManyRequests, 0, nil
 				default:
-					return 0, errors.New("test error")
+					return 0, 0, errors.New("test error")
 				}
 			}))
+		require.Equal(t, 5, counter)
 	}
 
 	{
 		var counter int
 		require.NoError(t,
-			SendWithRetry(5, func() (int, error) {
+			SendWithRetry(ctx, &RetryPolicy{MaxRetries: 5}, func() (int, time.Duration, error) {
 				counter++
 				switch counter {
 				case 1:
-					return http.StatusInternalServerError, nil
+					return http.StatusInternalServerError, 0, nil
 				case 2:
-					return http.StatusServiceUnavailable, nil
+					return http.StatusServiceUnavailable, 0, nil
 				case 3:
-					return 0, context.DeadlineExceeded
+					return 0, 0, context.DeadlineExceeded
 				default:
-					return http.StatusOK, nil
+					return http.StatusOK, 0, nil
 				}
 			}))
+		require.Equal(t, 4, counter)
+	}
+}
+
+func TestSendWithRetryDelay(t *testing.T) {
+
+	policy := &RetryPolicy{
+		MaxRetries: 3,
+		BaseDelay:  20 * time.Millisecond,
+		MaxDelay:   time.Second,
+	}
+
+	{
+		// test: exponential backoff
+		start := time.Now()
+		require.Equal(t,
+			ErrServiceUnavailable,
+			SendWithRetry(context.Background(), policy, func() (int, time.Duration, error) {
+				return http.StatusServiceUnavailable, 0, nil
+			}))
+		require.True(t, time.Since(start) >= 60*time.Millisecond) // 20ms + 40ms
+	}
+
+	{
+		// test: Retry-After is greater than the policy delay
+		var counter int
+		start := time.Now()
+		require.NoError(t,
+			SendWithRetry(context.Background(), policy, func() (int, time.Duration, error) {
+				counter++
+				if counter == 1 {
+					return http.StatusTooManyRequests, 100 * time.Millisecond, nil
+				}
+				return http.StatusOK, 0, nil
+			}))
+		require.Equal(t, 2, counter)
+		require.True(t, time.Since(start) >= 100*time.Millisecond)
+	}
+
+	{
+		// test: the next attempt is out of max elapsed time
+		var counter int
+		require.Equal(t,
+			ErrInternalServerError,
+			SendWithRetry(context.Background(), &RetryPolicy{MaxRetries: 3, MaxElapsed: time.Second},
+				func() (int, time.Duration, error) {
+					counter++
+					return http.StatusInternalServerError, time.Minute, nil
+				}))
+		require.Equal(t, 1, counter)
+	}
+
+	{
+		// test: the context is done while waiting
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		require.Equal(t,
+			context.DeadlineExceeded,
+			SendWithRetry(ctx, &RetryPolicy{MaxRetries: 3, BaseDelay: time.Minute, MaxDelay: time.Minute},
+				func() (int, time.Duration, error) {
+					return http.StatusServiceUnavailable, 0, nil
+				}))
 	}
+}
 
+func TestRetryPolicyDelay(t *testing.T) {
+
+	policy := &RetryPolicy{BaseDelay: time.Second, MaxDelay: 5 * time.Second}
+	for attempt, delay := range []time.Duration{
+		time.Second,
+		time.Second,
+		2 * time.Second,
+		4 * time.Second,
+		5 * time.Second,
+		5 * time.Second,
+	} {
+		require.Equal(t, delay, policy.Delay(attempt), attempt)
+	}
+
+	// the max delay is reduced by jitter up to a half
+	policy.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		delay := policy.Delay(10)
+		require.True(t, delay >= 2500*time.Millisecond && delay <= 5*time.Second, delay)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+
+	now := time.Date(2019, 11, 1, 10, 0, 0, 0, time.UTC)
+
+	for value, delay := range map[string]time.Duration{
+		"":                              0,
+		"invalid":                       0,
+		"-1":                            0,
+		"120":                           2 * time.Minute,
+		"Fri, 01 Nov 2019 10:00:30 GMT": 30 * time.Second,
+		"Fri, 01 Nov 2019 09:00:00 GMT": 0,
+	} {
+		header := http.Header{}
+		header.Set("Retry-After", value)
+		require.Equal(t, delay, ParseRetryAfter(header, now), value)
+	}
 }
 
 func TestDecodeJSONResponse(t *testing.T) {
@@ -1,6 +1,16 @@
 package provider
 
+import "time"
+
 type IRequest interface {
 	SetToken(token string)
 	ShouldIgnore() bool
+	// Clone returns a copy of the request: the copy can be sent to another device concurrently
+	Clone() IRequest
+}
+
+// IExpiringRequest is the request with the expiration time of the notification
+type IExpiringRequest interface {
+	// SetExpiration sets the time when the notification is no longer valid
+	SetExpiration(expiration time.Time)
 }
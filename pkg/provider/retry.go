@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultRetryBaseDelay  = 100 * time.Millisecond
+	DefaultRetryMaxDelay   = 5 * time.Second
+	DefaultRetryJitter     = 0.5
+	DefaultRetryMaxElapsed = 30 * time.Second
+)
+
+var (
+	jitterRand   = rand.New(rand.NewSource(time.Now().UnixNano()))
+	jitterRandMu sync.Mutex
+)
+
+// RetryPolicy is a policy of send retries: the delay before the next attempt
+// grows exponentially from BaseDelay up to MaxDelay. A part of the delay (Jitter)
+// is random: the workers don't retry at the same time
+type RetryPolicy struct {
+	// MaxRetries is count of send attempts
+	MaxRetries int
+	BaseDelay  time.Duration
+	MaxDelay   time.Duration
+	// Jitter is a random part of the delay: from 0 to 1
+	Jitter float64
+	// MaxElapsed is max time of all attempts. The attempts are unlimited if the value is zero
+	MaxElapsed time.Duration
+}
+
+// NewRetryPolicy returns the policy with default delays
+func NewRetryPolicy(maxRetries int) *RetryPolicy {
+	return &RetryPolicy{
+		MaxRetries: maxRetries,
+		BaseDelay:  DefaultRetryBaseDelay,
+		MaxDelay:   DefaultRetryMaxDelay,
+		Jitter:     DefaultRetryJitter,
+		MaxElapsed: DefaultRetryMaxElapsed,
+	}
+}
+
+// Delay returns the delay before the attempt (from 1): the delay is reduced by random jitter
+func (p *RetryPolicy) Delay(attempt int) time.Duration {
+
+	delay := p.BaseDelay
+	for i := 1; i < attempt && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+
+	if delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+
+	if p.Jitter > 0 && delay > 0 {
+		jitterRandMu.Lock()
+		random := jitterRand.Float64()
+		jitterRandMu.Unlock()
+
+		delay -= time.Duration(float64(delay) * p.Jitter * random)
+	}
+
+	return delay
+}
+
+func (p *RetryPolicy) maxRetries() int {
+
+	if p == nil || p.MaxRetries <= 0 {
+		return 1
+	}
+
+	return p.MaxRetries
+}
+
+// ParseRetryAfter returns the delay of the Retry-After header:
+// https://tools.ietf.org/html/rfc7231#section-7.1.3
+// The header value is delay in seconds or http date
+func ParseRetryAfter(header http.Header, now time.Time) time.Duration {
+
+	value := header.Get("Retry-After")
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+
+	date, err := http.ParseTime(value)
+	if err != nil || date.Before(now) {
+		return 0
+	}
+
+	return date.Sub(now)
+}
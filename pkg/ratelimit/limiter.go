@@ -0,0 +1,140 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	// DecreaseFactor reduces the rate when the provider throttles the requests
+	DecreaseFactor = 0.5
+	// MinRateFactor is the min part of the configured rate
+	MinRateFactor = 0.05
+	// RecoveryInterval is an interval of the rate recovery after throttling
+	RecoveryInterval = 10 * time.Second
+	// RecoveryFactor is a part of the configured rate that is restored every RecoveryInterval
+	RecoveryFactor = 0.1
+)
+
+// Limiter is an adaptive token bucket: the rate is reduced when the provider
+// returns 429 and is restored slowly up to the configured value
+type Limiter struct {
+	mu sync.Mutex
+	// limit is the configured rate: messages per second
+	limit float64
+	rate  float64
+	burst float64
+	// tokens is negative if the requests wait for tokens
+	tokens     float64
+	last       time.Time
+	lastChange time.Time
+	now        func() time.Time
+}
+
+// New returns the limiter with the rate (messages per second) and the burst.
+// If the burst is less than 1, the burst is equal to 1
+func New(rate float64, burst int) *Limiter {
+
+	if burst < 1 {
+		burst = 1
+	}
+
+	l := &Limiter{
+		limit: rate,
+		rate:  rate,
+		burst: float64(burst),
+		now:   time.Now,
+	}
+
+	l.tokens = l.burst
+	l.last = l.now()
+	l.lastChange = l.last
+
+	return l
+}
+
+// Wait waits for a token until the context is done
+func (l *Limiter) Wait(ctx context.Context) error {
+
+	l.mu.Lock()
+	l.advance(l.now())
+	l.tokens--
+	delay := time.Duration(0)
+	if l.tokens < 0 {
+		delay = time.Duration(-l.tokens / l.rate * float64(time.Second))
+	}
+	l.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+
+	case <-ctx.Done():
+		// the token isn't used
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+
+		return ctx.Err()
+	}
+}
+
+// Throttled reduces the rate: the provider returned 429
+func (l *Limiter) Throttled() {
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.advance(now)
+
+	l.rate *= DecreaseFactor
+	if minRate := l.limit * MinRateFactor; l.rate < minRate {
+		l.rate = minRate
+	}
+
+	l.lastChange = now
+}
+
+// Rate returns the current rate: messages per second
+func (l *Limiter) Rate() float64 {
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.advance(l.now())
+
+	return l.rate
+}
+
+// advance restores the rate and adds the tokens for the elapsed time
+func (l *Limiter) advance(now time.Time) {
+
+	if l.rate < l.limit {
+		if steps := now.Sub(l.lastChange) / RecoveryInterval; steps > 0 {
+			l.rate += float64(steps) * l.limit * RecoveryFactor
+			if l.rate > l.limit {
+				l.rate = l.limit
+			}
+
+			l.lastChange = l.lastChange.Add(steps * RecoveryInterval)
+		}
+	}
+
+	if elapsed := now.Sub(l.last); elapsed > 0 {
+		l.tokens += elapsed.Seconds() * l.rate
+		if l.tokens > l.burst {
+			l.tokens = l.burst
+		}
+
+		l.last = now
+	}
+}
@@ -0,0 +1,66 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimiterWait(t *testing.T) {
+
+	l := New(100, 2)
+
+	// the burst isn't limited
+	start := time.Now()
+	require.NoError(t, l.Wait(context.Background()))
+	require.NoError(t, l.Wait(context.Background()))
+	require.True(t, time.Since(start) < 10*time.Millisecond)
+
+	start = time.Now()
+	require.NoError(t, l.Wait(context.Background()))
+	require.True(t, time.Since(start) >= 5*time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	for i := 0; i < 10; i++ {
+		if err := l.Wait(ctx); err != nil {
+			require.Equal(t, context.Canceled, err)
+			return
+		}
+	}
+
+	require.Fail(t, "the limiter doesn't wait")
+}
+
+func TestLimiterThrottled(t *testing.T) {
+
+	now := time.Now()
+
+	l := New(100, 1)
+	l.now = func() time.Time { return now }
+	l.last = now
+	l.lastChange = now
+
+	require.Equal(t, 100.0, l.Rate())
+
+	l.Throttled()
+	require.Equal(t, 50.0, l.Rate())
+
+	for i := 0; i < 10; i++ {
+		l.Throttled()
+	}
+	require.Equal(t, 100*MinRateFactor, l.Rate())
+
+	// the rate is restored slowly
+	now = now.Add(RecoveryInterval - time.Millisecond)
+	require.Equal(t, 100*MinRateFactor, l.Rate())
+
+	now = now.Add(time.Millisecond)
+	require.InDelta(t, 100*(MinRateFactor+RecoveryFactor), l.Rate(), 0.0001)
+
+	now = now.Add(100 * RecoveryInterval)
+	require.Equal(t, 100.0, l.Rate())
+}
@@ -0,0 +1,75 @@
+package retry
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+)
+
+const (
+	DefaultBaseDelay      = time.Second
+	DefaultMaxDelay       = 5 * time.Minute
+	DefaultMaxAge         = time.Hour
+	DefaultPollInterval   = time.Second
+	DefaultMaxConcurrency = 10
+	DefaultBatchSize      = 100
+)
+
+type Config struct {
+	// Dir is a directory of the queue. The queue is disabled if the value is empty
+	Dir          string        `mapstructure:"dir"`
+	BaseDelay    time.Duration `mapstructure:"base-delay"`
+	MaxDelay     time.Duration `mapstructure:"max-delay"`
+	MaxAge       time.Duration `mapstructure:"max-age"`
+	PollInterval time.Duration `mapstructure:"poll-interval"`
+	// MaxConcurrency is a count of notifications sent at the same time
+	MaxConcurrency int `mapstructure:"max-concurrency"`
+	// BatchSize is a max count of notifications taken from the queue and not completed yet
+	BatchSize int `mapstructure:"batch-size"`
+}
+
+// NewConfig reads the queue settings. A nil source returns the disabled queue config
+func NewConfig(src *viper.Viper) (*Config, error) {
+
+	c := &Config{}
+	if src != nil {
+		if err := src.Unmarshal(c); err != nil {
+			return nil, err
+		}
+	}
+
+	if c.BaseDelay <= 0 {
+		c.BaseDelay = DefaultBaseDelay
+	}
+
+	if c.MaxDelay <= 0 {
+		c.MaxDelay = DefaultMaxDelay
+	}
+
+	if c.MaxAge <= 0 {
+		c.MaxAge = DefaultMaxAge
+	}
+
+	if c.PollInterval <= 0 {
+		c.PollInterval = DefaultPollInterval
+	}
+
+	if c.MaxConcurrency <= 0 {
+		c.MaxConcurrency = DefaultMaxConcurrency
+	}
+
+	if c.BatchSize <= 0 {
+		c.BatchSize = DefaultBatchSize
+	}
+
+	if c.BaseDelay > c.MaxDelay {
+		return nil, errors.New("invalid `base-delay`: greater than `max-delay`")
+	}
+
+	return c, nil
+}
+
+func (c *Config) Enabled() bool {
+	return c.Dir != ""
+}
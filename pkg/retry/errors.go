@@ -0,0 +1,25 @@
+package retry
+
+// permanentError is an error after that the notification can't be sent
+type permanentError struct {
+	err error
+}
+
+// Permanent marks the error as permanent: the notification
+// is moved to the dead-letter store without new attempts
+func Permanent(err error) error {
+	return &permanentError{err: err}
+}
+
+func IsPermanent(err error) bool {
+	_, ok := err.(*permanentError)
+	return ok
+}
+
+func (e *permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e *permanentError) Cause() error {
+	return e.err
+}
@@ -0,0 +1,472 @@
+package retry
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dialogs/dialog-push-service/pkg/atomicfile"
+	"github.com/dialogs/dialog-push-service/pkg/metric"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const (
+	dirQueue      = "queue"
+	dirDeadLetter = "dead"
+	fileExt       = ".json"
+)
+
+// Handler sends the notification again. If the handler returns an error,
+// the notification is scheduled for the next attempt. The permanent error
+// moves the notification to the dead-letter store
+type Handler func(ctx context.Context, item *Item) error
+
+// Item is a notification for a device
+type Item struct {
+	ID            string          `json:"id"`
+	ProjectID     string          `json:"project_id"`
+	DeviceToken   string          `json:"device_token"`
+	CorrelationID string          `json:"correlation_id,omitempty"`
+	Priority      int             `json:"priority,omitempty"`
+	Payload       json.RawMessage `json:"payload"`
+	Attempt       int             `json:"attempt"`
+	CreatedAt     time.Time       `json:"created_at"`
+	NextAttemptAt time.Time       `json:"next_attempt_at"`
+	// Deadline is the end of the notification TTL
+	Deadline  time.Time `json:"deadline"`
+	LastError string    `json:"last_error,omitempty"`
+}
+
+// Queue is a durable on-disk queue of notifications for retry. Every item
+// is stored in a separate file: the queue survives restarts
+type Queue struct {
+	cfg    Config
+	logger *zap.Logger
+	metric *metric.Retry
+
+	mu       sync.Mutex
+	items    map[string]*Item
+	inflight map[string]struct{}
+}
+
+// Open loads the stored items of the queue
+func Open(cfg *Config, logger *zap.Logger, svcMetric *metric.Service) (*Queue, error) {
+
+	if !cfg.Enabled() {
+		return nil, errors.New("retry queue: empty `dir`")
+	}
+
+	for _, dir := range []string{dirQueue, dirDeadLetter} {
+		if err := os.MkdirAll(filepath.Join(cfg.Dir, dir), 0700); err != nil {
+			return nil, errors.Wrap(err, "retry queue")
+		}
+	}
+
+	q := &Queue{
+		cfg:      *cfg,
+		logger:   logger.With(zap.String("component", "retry queue")),
+		metric:   svcMetric.GetRetryMetrics(),
+		items:    make(map[string]*Item),
+		inflight: make(map[string]struct{}),
+	}
+
+	if err := q.load(); err != nil {
+		return nil, err
+	}
+
+	q.updateMetrics(time.Now())
+
+	return q, nil
+}
+
+// Push stores the notification for the next attempt. The notification
+// is sent until the TTL expires. The TTL is limited by the queue `max-age`
+func (q *Queue) Push(item *Item, ttl time.Duration) error {
+
+	now := time.Now()
+
+	id, err := newID(now)
+	if err != nil {
+		return err
+	}
+
+	if ttl <= 0 || ttl > q.cfg.MaxAge {
+		ttl = q.cfg.MaxAge
+	}
+
+	item.ID = id
+	item.Attempt = 0
+	item.CreatedAt = now
+	item.Deadline = now.Add(ttl)
+	item.NextAttemptAt = now.Add(q.Backoff(0))
+
+	if err := writeItem(q.itemPath(dirQueue, id), item); err != nil {
+		return err
+	}
+
+	q.mu.Lock()
+	q.items[id] = item
+	q.mu.Unlock()
+
+	q.updateMetrics(now)
+
+	return nil
+}
+
+// Len returns count of notifications in the queue
+func (q *Queue) Len() int {
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return len(q.items)
+}
+
+// Backoff returns the delay before the attempt: the delay grows exponentially
+// from `base-delay` up to `max-delay`
+func (q *Queue) Backoff(attempt int) time.Duration {
+
+	delay := q.cfg.BaseDelay
+	for i := 0; i < attempt && delay < q.cfg.MaxDelay; i++ {
+		delay *= 2
+	}
+
+	if delay > q.cfg.MaxDelay {
+		delay = q.cfg.MaxDelay
+	}
+
+	return delay
+}
+
+// Run replays the notifications until the context is done. Every poll takes
+// up to `batch-size` ready notifications (oldest first) and sends them by
+// `max-concurrency` goroutines: the backlog isn't sent at once after an outage
+func (q *Queue) Run(ctx context.Context, fn Handler) {
+
+	items := make(chan *Item, q.cfg.BatchSize)
+	wg := q.startWorkers(ctx, fn, items)
+	defer func() {
+		close(items)
+		wg.Wait()
+	}()
+
+	ticker := time.NewTicker(q.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			q.dispatch(items, time.Now())
+		}
+	}
+}
+
+// startWorkers starts the pool of `max-concurrency` goroutines sending the notifications
+func (q *Queue) startWorkers(ctx context.Context, fn Handler, items <-chan *Item) *sync.WaitGroup {
+
+	wg := &sync.WaitGroup{}
+
+	for i := 0; i < q.cfg.MaxConcurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for item := range items {
+				q.handle(ctx, fn, item)
+			}
+		}()
+	}
+
+	return wg
+}
+
+func (q *Queue) handle(ctx context.Context, fn Handler, item *Item) {
+
+	defer q.release(item.ID)
+
+	if ctx.Err() != nil {
+		// the queue is stopped: the notification is sent after restart
+		return
+	}
+
+	err := fn(ctx, item)
+	if err := q.complete(item, err); err != nil {
+		q.logger.Error("failed to update item", zap.String("id", item.ID), zap.Error(err))
+	}
+}
+
+// dispatch passes the ready notifications to the workers. The count of the
+// taken notifications doesn't exceed the capacity of the channel: the call isn't blocked
+func (q *Queue) dispatch(items chan<- *Item, now time.Time) {
+
+	for _, item := range q.takeReady(now, cap(items)) {
+		items <- item
+	}
+
+	q.updateMetrics(now)
+}
+
+// takeReady returns the oldest notifications with expired delay.
+// The count of the notifications in flight is limited by `limit`
+func (q *Queue) takeReady(now time.Time, limit int) []*Item {
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	limit -= len(q.inflight)
+	if limit <= 0 {
+		return nil
+	}
+
+	retval := make([]*Item, 0)
+	for id, item := range q.items {
+		if _, ok := q.inflight[id]; ok || item.NextAttemptAt.After(now) {
+			continue
+		}
+
+		retval = append(retval, item)
+	}
+
+	sort.Slice(retval, func(i, j int) bool {
+		if !retval[i].CreatedAt.Equal(retval[j].CreatedAt) {
+			return retval[i].CreatedAt.Before(retval[j].CreatedAt)
+		}
+		return retval[i].ID < retval[j].ID
+	})
+
+	if len(retval) > limit {
+		retval = retval[:limit]
+	}
+
+	for _, item := range retval {
+		q.inflight[item.ID] = struct{}{}
+	}
+
+	return retval
+}
+
+func (q *Queue) release(id string) {
+
+	q.mu.Lock()
+	delete(q.inflight, id)
+	q.mu.Unlock()
+}
+
+// complete removes the sent notification or schedules the next attempt
+func (q *Queue) complete(item *Item, sendErr error) error {
+
+	if sendErr == nil {
+		return q.remove(item)
+	}
+
+	next := *item
+	next.Attempt++
+	next.LastError = sendErr.Error()
+	next.NextAttemptAt = time.Now().Add(q.Backoff(next.Attempt))
+
+	l := q.logger.With(
+		zap.String("id", item.ID),
+		zap.String("project ID", item.ProjectID),
+		zap.String("correlation id", item.CorrelationID),
+		zap.Int("attempt", next.Attempt),
+		zap.Error(sendErr))
+
+	if IsPermanent(sendErr) || next.NextAttemptAt.After(next.Deadline) {
+		l.Warn("move to dead-letter store")
+		return q.moveToDeadLetter(&next)
+	}
+
+	l.Info("schedule next attempt", zap.Time("next attempt", next.NextAttemptAt))
+
+	if err := writeItem(q.itemPath(dirQueue, item.ID), &next); err != nil {
+		return err
+	}
+
+	q.mu.Lock()
+	q.items[item.ID] = &next
+	q.mu.Unlock()
+
+	return nil
+}
+
+func (q *Queue) remove(item *Item) error {
+
+	q.mu.Lock()
+	delete(q.items, item.ID)
+	q.mu.Unlock()
+
+	if err := os.Remove(q.itemPath(dirQueue, item.ID)); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "remove item")
+	}
+
+	return nil
+}
+
+func (q *Queue) moveToDeadLetter(item *Item) error {
+
+	if err := writeItem(q.itemPath(dirDeadLetter, item.ID), item); err != nil {
+		return err
+	}
+
+	q.metric.DeadLettersInc()
+
+	return q.remove(item)
+}
+
+// load reads the stored items. The broken items are moved to the dead-letter store
+func (q *Queue) load() error {
+
+	dir := filepath.Join(q.cfg.Dir, dirQueue)
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return errors.Wrap(err, "retry queue")
+	}
+
+	for _, info := range files {
+		name := info.Name()
+		path := filepath.Join(dir, name)
+
+		switch {
+		case info.IsDir():
+			continue
+
+		case strings.HasSuffix(name, atomicfile.TmpExt):
+			// the item wasn't written completely
+			if err := os.Remove(path); err != nil {
+				return errors.Wrap(err, "retry queue")
+			}
+			continue
+
+		case !strings.HasSuffix(name, fileExt):
+			continue
+		}
+
+		item, err := readItem(path)
+		if err != nil {
+			q.logger.Error("broken item", zap.String("file", name), zap.Error(err))
+
+			if err := os.Rename(path, filepath.Join(q.cfg.Dir, dirDeadLetter, name)); err != nil {
+				return errors.Wrap(err, "retry queue")
+			}
+			continue
+		}
+
+		q.items[item.ID] = item
+	}
+
+	q.logger.Info("loaded", zap.Int("items", len(q.items)))
+
+	return nil
+}
+
+func (q *Queue) updateMetrics(now time.Time) {
+
+	q.mu.Lock()
+	depth := len(q.items)
+	var oldest time.Time
+	for _, item := range q.items {
+		if oldest.IsZero() || item.CreatedAt.Before(oldest) {
+			oldest = item.CreatedAt
+		}
+	}
+	q.mu.Unlock()
+
+	age := 0.0
+	if !oldest.IsZero() {
+		age = now.Sub(oldest).Seconds()
+	}
+
+	q.metric.SetDepth(depth)
+	q.metric.SetAge(age)
+}
+
+func (q *Queue) itemPath(dir, id string) string {
+	return filepath.Join(q.cfg.Dir, dir, id+fileExt)
+}
+
+// DeadLetters returns the notifications of the dead-letter store sorted by creation time
+func (q *Queue) DeadLetters() ([]*Item, error) {
+
+	dir := filepath.Join(q.cfg.Dir, dirDeadLetter)
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "dead-letter store")
+	}
+
+	retval := make([]*Item, 0, len(files))
+	for _, info := range files {
+		if info.IsDir() || !strings.HasSuffix(info.Name(), fileExt) {
+			continue
+		}
+
+		item, err := readItem(filepath.Join(dir, info.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		retval = append(retval, item)
+	}
+
+	sort.Slice(retval, func(i, j int) bool {
+		return retval[i].CreatedAt.Before(retval[j].CreatedAt)
+	})
+
+	return retval, nil
+}
+
+// writeItem replaces the item file atomically
+func writeItem(path string, item *Item) error {
+
+	data, err := json.Marshal(item)
+	if err != nil {
+		return errors.Wrap(err, "encode item")
+	}
+
+	if err := atomicfile.Write(path, data); err != nil {
+		return errors.Wrap(err, "write item")
+	}
+
+	return nil
+}
+
+func readItem(path string) (*Item, error) {
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "read item")
+	}
+
+	item := &Item{}
+	if err := json.Unmarshal(data, item); err != nil {
+		return nil, errors.Wrap(err, "decode item")
+	}
+
+	if item.ID == "" {
+		return nil, errors.New("decode item: empty id")
+	}
+
+	return item, nil
+}
+
+// newID returns an unique sortable identifier of the item
+func newID(now time.Time) (string, error) {
+
+	suffix := make([]byte, 4)
+	if _, err := rand.Read(suffix); err != nil {
+		return "", errors.Wrap(err, "item id")
+	}
+
+	return strconv.FormatInt(now.UnixNano(), 10) + "-" + hex.EncodeToString(suffix), nil
+}
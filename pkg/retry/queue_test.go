@@ -0,0 +1,255 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dialogs/dialog-push-service/pkg/atomicfile"
+	"github.com/dialogs/dialog-push-service/pkg/metric"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestConfig(t *testing.T) {
+
+	cfg, err := NewConfig(nil)
+	require.NoError(t, err)
+	require.False(t, cfg.Enabled())
+	require.Equal(t,
+		&Config{
+			BaseDelay:      DefaultBaseDelay,
+			MaxDelay:       DefaultMaxDelay,
+			MaxAge:         DefaultMaxAge,
+			PollInterval:   DefaultPollInterval,
+			MaxConcurrency: DefaultMaxConcurrency,
+			BatchSize:      DefaultBatchSize,
+		},
+		cfg)
+
+	src := viper.New()
+	src.Set("dir", "/tmp")
+	src.Set("base-delay", "2m")
+	src.Set("max-delay", "1m")
+
+	_, err = NewConfig(src)
+	require.EqualError(t, err, "invalid `base-delay`: greater than `max-delay`")
+}
+
+func TestBackoff(t *testing.T) {
+
+	q := &Queue{cfg: Config{BaseDelay: time.Second, MaxDelay: 10 * time.Second}}
+
+	for attempt, delay := range []time.Duration{
+		time.Second,
+		2 * time.Second,
+		4 * time.Second,
+		8 * time.Second,
+		10 * time.Second,
+		10 * time.Second,
+	} {
+		require.Equal(t, delay, q.Backoff(attempt), attempt)
+	}
+
+	require.Equal(t, 10*time.Second, q.Backoff(1000))
+}
+
+func TestQueueReplay(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "retry")
+	require.NoError(t, err)
+	defer func() { require.NoError(t, os.RemoveAll(dir)) }()
+
+	cfg := getConfig(dir)
+
+	q, err := Open(cfg, zap.NewNop(), metric.New())
+	require.NoError(t, err)
+
+	require.NoError(t, q.Push(&Item{ProjectID: "p-1", DeviceToken: "token1", Payload: []byte(`{"a":1}`)}, time.Minute))
+	require.NoError(t, q.Push(&Item{ProjectID: "p-1", DeviceToken: "token2", Payload: []byte(`{"a":2}`)}, time.Minute))
+	require.Equal(t, 2, q.Len())
+
+	// the queue survives restarts
+	q, err = Open(cfg, zap.NewNop(), metric.New())
+	require.NoError(t, err)
+	require.Equal(t, 2, q.Len())
+
+	mu := sync.Mutex{}
+	attempts := map[string]int{}
+	handler := func(_ context.Context, item *Item) error {
+		mu.Lock()
+		attempts[item.DeviceToken]++
+		mu.Unlock()
+
+		if item.DeviceToken == "token2" && item.Attempt < 2 {
+			return errors.New("service unavailable")
+		}
+		return nil
+	}
+
+	stop := runQueue(q, handler)
+	waitEmpty(t, q)
+	stop()
+
+	require.Equal(t, map[string]int{"token1": 1, "token2": 3}, attempts)
+	require.Equal(t, 0, q.Len())
+
+	files, err := ioutil.ReadDir(filepath.Join(dir, dirQueue))
+	require.NoError(t, err)
+	require.Empty(t, files)
+}
+
+func TestQueueReplayBatch(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "retry")
+	require.NoError(t, err)
+	defer func() { require.NoError(t, os.RemoveAll(dir)) }()
+
+	cfg := getConfig(dir)
+	cfg.MaxConcurrency = 2
+	cfg.BatchSize = 3
+
+	q, err := Open(cfg, zap.NewNop(), metric.New())
+	require.NoError(t, err)
+
+	for _, token := range []string{"token1", "token2", "token3", "token4", "token5"} {
+		require.NoError(t, q.Push(&Item{ProjectID: "p-1", DeviceToken: token}, time.Minute))
+	}
+
+	mu := sync.Mutex{}
+	sent := []string{}
+	active, maxActive, maxInFlight := 0, 0, 0
+	handler := func(_ context.Context, item *Item) error {
+		mu.Lock()
+		sent = append(sent, item.DeviceToken)
+		active++
+		if active > maxActive {
+			maxActive = active
+		}
+
+		q.mu.Lock()
+		if len(q.inflight) > maxInFlight {
+			maxInFlight = len(q.inflight)
+		}
+		q.mu.Unlock()
+		mu.Unlock()
+
+		time.Sleep(20 * time.Millisecond)
+
+		mu.Lock()
+		active--
+		mu.Unlock()
+		return nil
+	}
+
+	time.Sleep(q.Backoff(0))
+	stop := runQueue(q, handler)
+	waitEmpty(t, q)
+	stop()
+
+	// the oldest notifications are sent first by the limited pool,
+	// the notifications in flight are limited by the batch size
+	require.ElementsMatch(t, []string{"token1", "token2", "token3"}, sent[:3])
+	require.ElementsMatch(t, []string{"token1", "token2", "token3", "token4", "token5"}, sent)
+	require.Equal(t, 2, maxActive)
+	require.Equal(t, 3, maxInFlight)
+}
+
+func TestQueueDeadLetter(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "retry")
+	require.NoError(t, err)
+	defer func() { require.NoError(t, os.RemoveAll(dir)) }()
+
+	q, err := Open(getConfig(dir), zap.NewNop(), metric.New())
+	require.NoError(t, err)
+
+	// the TTL is shorter than the next delay
+	require.NoError(t, q.Push(&Item{ProjectID: "p-1", DeviceToken: "token1", CorrelationID: "id-1"}, 15*time.Millisecond))
+	require.NoError(t, q.Push(&Item{ProjectID: "p-2", DeviceToken: "token2", CorrelationID: "id-2"}, time.Minute))
+
+	stop := runQueue(q, func(_ context.Context, item *Item) error {
+		if item.ProjectID == "p-2" {
+			return Permanent(errors.New("unknown project"))
+		}
+		return errors.New("service unavailable")
+	})
+	waitEmpty(t, q)
+	stop()
+
+	deadLetters, err := q.DeadLetters()
+	require.NoError(t, err)
+	require.Len(t, deadLetters, 2)
+	require.Equal(t, "id-1", deadLetters[0].CorrelationID)
+	require.Equal(t, "service unavailable", deadLetters[0].LastError)
+	require.Equal(t, 1, deadLetters[0].Attempt)
+	require.Equal(t, "id-2", deadLetters[1].CorrelationID)
+	require.Equal(t, "unknown project", deadLetters[1].LastError)
+}
+
+func TestQueueBrokenItem(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "retry")
+	require.NoError(t, err)
+	defer func() { require.NoError(t, os.RemoveAll(dir)) }()
+
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, dirQueue), 0700))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, dirQueue, "1.json"), []byte("{"), 0600))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, dirQueue, "2.json"+atomicfile.TmpExt), []byte("{"), 0600))
+
+	q, err := Open(getConfig(dir), zap.NewNop(), metric.New())
+	require.NoError(t, err)
+	require.Equal(t, 0, q.Len())
+
+	_, err = os.Stat(filepath.Join(dir, dirDeadLetter, "1.json"))
+	require.NoError(t, err)
+
+	files, err := ioutil.ReadDir(filepath.Join(dir, dirQueue))
+	require.NoError(t, err)
+	require.Empty(t, files)
+}
+
+func getConfig(dir string) *Config {
+	return &Config{
+		Dir:            dir,
+		BaseDelay:      10 * time.Millisecond,
+		MaxDelay:       time.Second,
+		MaxAge:         time.Hour,
+		PollInterval:   10 * time.Millisecond,
+		MaxConcurrency: 4,
+		BatchSize:      10,
+	}
+}
+
+// runQueue replays the notifications of the queue until stop is called
+func runQueue(q *Queue, fn Handler) (stop func()) {
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		q.Run(ctx, fn)
+	}()
+
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+// waitEmpty waits until all notifications of the queue are sent or moved to the dead-letter store
+func waitEmpty(t *testing.T, q *Queue) {
+	t.Helper()
+
+	for deadline := time.Now().Add(5 * time.Second); q.Len() > 0; {
+		require.True(t, time.Now().Before(deadline), "queue isn't empty")
+		time.Sleep(10 * time.Millisecond)
+	}
+}
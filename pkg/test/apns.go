@@ -0,0 +1,129 @@
+package test
+
+import (
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"golang.org/x/net/http2"
+)
+
+const apnsPathPrefix = "/3/device/"
+
+// APNsServer is a fake of Apple Push Notification service (HTTP/2 with TLS):
+// https://developer.apple.com/library/archive/documentation/NetworkingInternet/Conceptual/RemoteNotificationsPG/CommunicatingwithAPNs.html
+type APNsServer struct {
+	*httptest.Server
+	*recorder
+}
+
+// NewAPNsServer starts the fake server. The server accepts certificate-based
+// and token-based connections without verification of credentials
+func NewAPNsServer() *APNsServer {
+
+	s := &APNsServer{
+		recorder: newRecorder(),
+	}
+
+	s.Server = httptest.NewUnstartedServer(http.HandlerFunc(s.handle))
+	if err := http2.ConfigureServer(s.Server.Config, nil); err != nil {
+		panic("apns server: " + err.Error())
+	}
+	s.Server.TLS = &tls.Config{
+		ClientAuth: tls.RequestClientCert,
+		NextProtos: []string{http2.NextProtoTLS},
+	}
+	s.Server.StartTLS()
+
+	return s
+}
+
+func (s *APNsServer) handle(w http.ResponseWriter, r *http.Request) {
+
+	if r.Method != http.MethodPost || !strings.HasPrefix(r.URL.Path, apnsPathPrefix) {
+		writeAPNsReply(w, "", http.StatusMethodNotAllowed, "MethodNotAllowed", 0)
+		return
+	}
+
+	body, err := s.record(r)
+	if err != nil {
+		writeAPNsReply(w, "", http.StatusBadRequest, "BadMessageId", 0)
+		return
+	}
+
+	id := r.Header.Get("apns-id")
+	if id == "" {
+		id = newAPNsID()
+	}
+
+	token := strings.TrimPrefix(r.URL.Path, apnsPathPrefix)
+	if token == "" {
+		writeAPNsReply(w, id, http.StatusBadRequest, "MissingDeviceToken", 0)
+		return
+	}
+
+	if !json.Valid(body) {
+		writeAPNsReply(w, id, http.StatusBadRequest, "PayloadEmpty", 0)
+		return
+	}
+
+	switch s.reply(token) {
+	case ReplySuccess:
+		w.Header().Set("apns-id", id)
+		w.WriteHeader(http.StatusOK)
+
+	case ReplyBadDeviceToken:
+		writeAPNsReply(w, id, http.StatusBadRequest, "BadDeviceToken", 0)
+
+	case ReplyUnregistered:
+		ts := time.Now().UnixNano() / int64(time.Millisecond)
+		writeAPNsReply(w, id, http.StatusGone, "Unregistered", ts)
+
+	case ReplyTooManyRequests:
+		writeAPNsReply(w, id, http.StatusTooManyRequests, "TooManyRequests", 0)
+
+	case ReplyInternalError:
+		writeAPNsReply(w, id, http.StatusInternalServerError, "InternalServerError", 0)
+
+	case ReplyMalformed:
+		w.Header().Set("apns-id", id)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("{"))
+	}
+}
+
+// writeAPNsReply writes the error response:
+// Table 8-5 APNs JSON data keys
+// https://developer.apple.com/library/archive/documentation/NetworkingInternet/Conceptual/RemoteNotificationsPG/CommunicatingwithAPNs.html
+func writeAPNsReply(w http.ResponseWriter, id string, statusCode int, reason string, timestamp int64) {
+
+	body := map[string]interface{}{
+		"reason": reason,
+	}
+	if timestamp > 0 {
+		body["timestamp"] = timestamp
+	}
+
+	if id != "" {
+		w.Header().Set("apns-id", id)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(body)
+}
+
+// newAPNsID returns a canonical UUID. Example: CDB997A0-0C7C-8E2E-DBB5-13E89D5C756E
+func newAPNsID() string {
+
+	b := make([]byte, 16)
+	rand.Read(b)
+
+	id := strings.ToUpper(hex.EncodeToString(b))
+
+	return id[:8] + "-" + id[8:12] + "-" + id[12:16] + "-" + id[16:20] + "-" + id[20:]
+}
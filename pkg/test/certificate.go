@@ -0,0 +1,179 @@
+package test
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// Apple certificate extensions (see pkg/provider/ans/certificate.go)
+var (
+	oidPushDevelop    = asn1.ObjectIdentifier([]int{1, 2, 840, 113635, 100, 6, 3, 1})
+	oidPushProduction = asn1.ObjectIdentifier([]int{1, 2, 840, 113635, 100, 6, 3, 2})
+	oidVoIP           = asn1.ObjectIdentifier([]int{1, 2, 840, 113635, 100, 6, 3, 5})
+	oidTopics         = asn1.ObjectIdentifier([]int{1, 2, 840, 113635, 100, 6, 3, 6})
+	oidUID            = asn1.ObjectIdentifier([]int{0, 9, 2342, 19200300, 100, 1, 1})
+)
+
+// AppleCertificate is a settings of a fake APNs client certificate
+type AppleCertificate struct {
+	Develop    bool
+	Production bool
+	VoIP       bool
+	// Topics of the certificate. Example: im.dlg.app, im.dlg.app.voip
+	Topics []string
+	// UID of the subject: the topic of the certificates without the topics extension
+	UID string
+	// By default the certificate is valid for one year
+	NotAfter time.Time
+}
+
+// NewAppleCertificatePem returns a self-signed client certificate and
+// the private key in pem format (the same file format as for 'pem' option)
+func NewAppleCertificatePem(cfg AppleCertificate) ([]byte, error) {
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, err
+	}
+
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	if err != nil {
+		return nil, err
+	}
+
+	notAfter := cfg.NotAfter
+	if notAfter.IsZero() {
+		notAfter = time.Now().AddDate(1, 0, 0)
+	}
+
+	notBefore := time.Now().Add(-time.Hour)
+	if notBefore.After(notAfter) {
+		notBefore = notAfter.Add(-time.Hour)
+	}
+
+	commonName := "Apple Push Services: test"
+	if len(cfg.Topics) > 0 {
+		commonName = "Apple Push Services: " + cfg.Topics[0]
+	}
+
+	var subjectNames []pkix.AttributeTypeAndValue
+	if cfg.UID != "" {
+		subjectNames = append(subjectNames, pkix.AttributeTypeAndValue{Type: oidUID, Value: cfg.UID})
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject: pkix.Name{
+			CommonName: commonName,
+			ExtraNames: subjectNames,
+		},
+		NotBefore:   notBefore,
+		NotAfter:    notAfter,
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	for _, ext := range []struct {
+		Exists bool
+		ID     asn1.ObjectIdentifier
+	}{
+		{cfg.Develop, oidPushDevelop},
+		{cfg.Production, oidPushProduction},
+		{cfg.VoIP, oidVoIP},
+	} {
+		if ext.Exists {
+			template.ExtraExtensions = append(template.ExtraExtensions, pkix.Extension{
+				Id:    ext.ID,
+				Value: asn1.NullBytes,
+			})
+		}
+	}
+
+	if len(cfg.Topics) > 0 {
+		value, err := marshalTopics(cfg.Topics)
+		if err != nil {
+			return nil, err
+		}
+
+		template.ExtraExtensions = append(template.ExtraExtensions, pkix.Extension{
+			Id:    oidTopics,
+			Value: value,
+		})
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, err
+	}
+
+	retval := bytes.NewBuffer(nil)
+	if err := pem.Encode(retval, &pem.Block{Type: "CERTIFICATE", Bytes: der}); err != nil {
+		return nil, err
+	}
+
+	if err := pem.Encode(retval, &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}); err != nil {
+		return nil, err
+	}
+
+	return retval.Bytes(), nil
+}
+
+// NewAuthKeyPem returns a signing key for token-based authentication (.p8 file)
+func NewAuthKeyPem() ([]byte, error) {
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil
+}
+
+// marshalTopics encodes topics in the format of Apple certificates:
+// SEQUENCE { UTF8String <topic>, SEQUENCE { UTF8String <topic kind> }, ... }
+func marshalTopics(topics []string) ([]byte, error) {
+
+	content := bytes.NewBuffer(nil)
+	for _, topic := range topics {
+		kind := "app"
+		if strings.HasSuffix(topic, ".voip") {
+			kind = "voip"
+		} else if strings.HasSuffix(topic, ".complication") {
+			kind = "complication"
+		}
+
+		value, err := asn1.Marshal(asn1.RawValue{Tag: asn1.TagUTF8String, Bytes: []byte(topic)})
+		if err != nil {
+			return nil, err
+		}
+		content.Write(value)
+
+		kindValue, err := asn1.Marshal(asn1.RawValue{Tag: asn1.TagUTF8String, Bytes: []byte(kind)})
+		if err != nil {
+			return nil, err
+		}
+
+		kindBlock, err := asn1.Marshal(asn1.RawValue{Tag: asn1.TagSequence, IsCompound: true, Bytes: kindValue})
+		if err != nil {
+			return nil, err
+		}
+		content.Write(kindBlock)
+	}
+
+	return asn1.Marshal(asn1.RawValue{Tag: asn1.TagSequence, IsCompound: true, Bytes: content.Bytes()})
+}
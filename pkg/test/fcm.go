@@ -0,0 +1,204 @@
+package test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+)
+
+const (
+	fcmTokenPath   = "/token"
+	fcmAccessToken = "fake-access-token-"
+)
+
+// FCMServer is a fake of Firebase Cloud Messaging HTTP v1 API with OAuth token endpoint:
+// https://firebase.google.com/docs/reference/fcm/rest/v1/projects.messages/send
+type FCMServer struct {
+	*httptest.Server
+	*recorder
+
+	projectID string
+	tokens    int64
+	messages  int64
+}
+
+// NewFCMServer starts the fake server for the project
+func NewFCMServer(projectID string) *FCMServer {
+
+	s := &FCMServer{
+		recorder:  newRecorder(),
+		projectID: projectID,
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(fcmTokenPath, s.handleToken)
+	mux.HandleFunc("/v1/projects/", s.handleSend)
+
+	s.Server = httptest.NewTLSServer(mux)
+
+	return s
+}
+
+// TokenEndpoint returns OAuth token URL of the server
+func (s *FCMServer) TokenEndpoint() string {
+	return s.URL + fcmTokenPath
+}
+
+// CountTokens returns count of issued OAuth tokens
+func (s *FCMServer) CountTokens() int {
+	return int(atomic.LoadInt64(&s.tokens))
+}
+
+// ServiceAccount returns a service account (service-account.json) with
+// a new private key and the token URL of the server
+func (s *FCMServer) ServiceAccount() ([]byte, error) {
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, err
+	}
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(map[string]string{
+		"type":           "service_account",
+		"project_id":     s.projectID,
+		"private_key_id": "fake-key-id",
+		"private_key":    string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})),
+		"client_email":   "fake@" + s.projectID + ".iam.gserviceaccount.com",
+		"client_id":      "1",
+		"token_uri":      s.TokenEndpoint(),
+	})
+}
+
+func (s *FCMServer) handleToken(w http.ResponseWriter, r *http.Request) {
+
+	if r.Method != http.MethodPost || r.ParseForm() != nil || r.PostForm.Get("assertion") == "" {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error":"invalid_grant"}`))
+		return
+	}
+
+	n := atomic.AddInt64(&s.tokens, 1)
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"access_token": fcmAccessToken + strconv.FormatInt(n, 10),
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+	})
+}
+
+func (s *FCMServer) handleSend(w http.ResponseWriter, r *http.Request) {
+
+	body, err := s.record(r)
+	if err != nil {
+		writeFCMError(w, http.StatusBadRequest, "INVALID_ARGUMENT", "", err.Error())
+		return
+	}
+
+	if r.Method != http.MethodPost || r.URL.Path != "/v1/projects/"+s.projectID+"/messages:send" {
+		writeFCMError(w, http.StatusNotFound, "NOT_FOUND", "", "Requested entity was not found.")
+		return
+	}
+
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "Bearer "+fcmAccessToken) {
+		writeFCMError(w, http.StatusUnauthorized, "UNAUTHENTICATED", "THIRD_PARTY_AUTH_ERROR",
+			"Request had invalid authentication credentials.")
+		return
+	}
+
+	req := &struct {
+		ValidateOnly bool `json:"validate_only"`
+		Message      *struct {
+			Token string `json:"token"`
+		} `json:"message"`
+	}{}
+
+	if err := json.Unmarshal(body, req); err != nil || req.Message == nil {
+		writeFCMError(w, http.StatusBadRequest, "INVALID_ARGUMENT", "INVALID_ARGUMENT",
+			"Invalid JSON payload received.")
+		return
+	}
+
+	reply := ReplyBadDeviceToken
+	if req.Message.Token != "" {
+		reply = s.reply(req.Message.Token)
+	}
+
+	switch reply {
+	case ReplySuccess:
+		messageID := "fake_message_id"
+		if !req.ValidateOnly {
+			messageID = "0:" + strconv.FormatInt(atomic.AddInt64(&s.messages, 1), 10)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]string{
+			"name": "projects/" + s.projectID + "/messages/" + messageID,
+		})
+
+	case ReplyBadDeviceToken:
+		writeFCMError(w, http.StatusBadRequest, "INVALID_ARGUMENT", "INVALID_ARGUMENT",
+			"The registration token is not a valid FCM registration token",
+			map[string]interface{}{
+				"@type": "type.googleapis.com/google.rpc.BadRequest",
+				"fieldViolations": []map[string]string{
+					{
+						"field":       "message.token",
+						"description": "The registration token is not a valid FCM registration token",
+					},
+				},
+			})
+
+	case ReplyUnregistered:
+		writeFCMError(w, http.StatusNotFound, "NOT_FOUND", "UNREGISTERED", "Requested entity was not found.")
+
+	case ReplyTooManyRequests:
+		writeFCMError(w, http.StatusTooManyRequests, "RESOURCE_EXHAUSTED", "QUOTA_EXCEEDED",
+			"Quota exceeded for quota metric 'Send requests'.")
+
+	case ReplyInternalError:
+		writeFCMError(w, http.StatusInternalServerError, "INTERNAL", "INTERNAL", "Internal error encountered.")
+
+	case ReplyMalformed:
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte("{"))
+	}
+}
+
+// writeFCMError writes the error response:
+// https://firebase.google.com/docs/reference/fcm/rest/v1/ErrorCode
+func writeFCMError(w http.ResponseWriter, statusCode int, status, errorCode, message string, details ...interface{}) {
+
+	if errorCode != "" {
+		details = append([]interface{}{
+			map[string]string{
+				"@type":     "type.googleapis.com/google.firebase.fcm.v1.FcmError",
+				"errorCode": errorCode,
+			},
+		}, details...)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":    statusCode,
+			"message": message,
+			"status":  status,
+			"details": details,
+		},
+	})
+}
@@ -0,0 +1,151 @@
+package test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"sync/atomic"
+)
+
+// GCMServer is a fake of legacy Firebase Cloud Messaging HTTP API:
+// https://firebase.google.com/docs/cloud-messaging/http-server-ref
+type GCMServer struct {
+	*httptest.Server
+	*recorder
+
+	key      string
+	messages int64
+
+	mu           sync.Mutex
+	canonicalIDs map[string]string
+}
+
+// NewGCMServer starts the fake server. The server accepts requests with the server key only
+func NewGCMServer(key string) *GCMServer {
+
+	s := &GCMServer{
+		recorder:     newRecorder(),
+		key:          key,
+		canonicalIDs: make(map[string]string),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/fcm/send", s.handleSend)
+
+	s.Server = httptest.NewTLSServer(mux)
+
+	return s
+}
+
+// SetCanonicalID sets the canonical registration ID that is returned
+// on the successful sending to the device token
+func (s *GCMServer) SetCanonicalID(token, canonicalID string) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.canonicalIDs[token] = canonicalID
+}
+
+// Reset drops the scripted replies, the canonical IDs and the received requests
+func (s *GCMServer) Reset() {
+
+	s.recorder.Reset()
+
+	s.mu.Lock()
+	s.canonicalIDs = make(map[string]string)
+	s.mu.Unlock()
+}
+
+func (s *GCMServer) canonicalID(token string) string {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.canonicalIDs[token]
+}
+
+func (s *GCMServer) handleSend(w http.ResponseWriter, r *http.Request) {
+
+	body, err := s.record(r)
+	if err != nil || r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if r.Header.Get("Authorization") != "key="+s.key {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte("<HTML><TITLE>Unauthorized</TITLE></HTML>"))
+		return
+	}
+
+	req := &struct {
+		To     string `json:"to"`
+		DryRun bool   `json:"dry_run"`
+	}{}
+
+	// the body 'null' resets the pointer
+	if err := json.Unmarshal(body, &req); err != nil || req == nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("JSON_PARSING_ERROR\n"))
+		return
+	}
+
+	if req.To == "" {
+		s.writeResult(w, "", "", "MissingRegistration")
+		return
+	}
+
+	switch s.reply(req.To) {
+	case ReplySuccess:
+		messageID := "fake_message_id"
+		if !req.DryRun {
+			messageID = "0:" + strconv.FormatInt(atomic.AddInt64(&s.messages, 1), 10)
+		}
+		s.writeResult(w, messageID, s.canonicalID(req.To), "")
+
+	case ReplyBadDeviceToken:
+		s.writeResult(w, "", "", "InvalidRegistration")
+
+	case ReplyUnregistered:
+		s.writeResult(w, "", "", "NotRegistered")
+
+	case ReplyTooManyRequests:
+		w.WriteHeader(http.StatusTooManyRequests)
+
+	case ReplyInternalError:
+		w.WriteHeader(http.StatusInternalServerError)
+
+	case ReplyMalformed:
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte("{"))
+	}
+}
+
+// writeResult writes the response for one device token:
+// https://firebase.google.com/docs/cloud-messaging/http-server-ref#interpret-downstream
+func (s *GCMServer) writeResult(w http.ResponseWriter, messageID, canonicalID, errorCode string) {
+
+	result := map[string]string{}
+	success, failure := 1, 0
+
+	if errorCode != "" {
+		result["error"] = errorCode
+		success, failure = 0, 1
+	} else {
+		result["message_id"] = messageID
+		if canonicalID != "" {
+			result["registration_id"] = canonicalID
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"multicast_id": 1000 + atomic.AddInt64(&s.messages, 1),
+		"success":      success,
+		"failure":      failure,
+		"results":      []map[string]string{result},
+	})
+}
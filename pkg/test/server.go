@@ -0,0 +1,156 @@
+package test
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+)
+
+// Reply is a scripted answer of a fake provider server for a device token
+type Reply int
+
+const (
+	// ReplySuccess - the notification is accepted
+	ReplySuccess Reply = iota
+	// ReplyBadDeviceToken - the device token is invalid
+	ReplyBadDeviceToken
+	// ReplyUnregistered - the device token is no longer active for the topic (application)
+	ReplyUnregistered
+	// ReplyTooManyRequests - http status 429
+	ReplyTooManyRequests
+	// ReplyInternalError - http status 500
+	ReplyInternalError
+	// ReplyMalformed - http status 200 with invalid response body
+	ReplyMalformed
+)
+
+// RecordedRequest is a request received by a fake provider server
+type RecordedRequest struct {
+	Path   string
+	Header http.Header
+	Body   []byte
+}
+
+// recorder keeps scripted replies and received requests of a fake server
+type recorder struct {
+	mu           sync.Mutex
+	defaultReply Reply
+	replies      map[string]Reply
+	requests     []*RecordedRequest
+}
+
+func newRecorder() *recorder {
+	return &recorder{
+		replies:  make(map[string]Reply),
+		requests: make([]*RecordedRequest, 0),
+	}
+}
+
+// SetReply sets the answer for the device token
+func (r *recorder) SetReply(token string, reply Reply) {
+	r.mu.Lock()
+	r.replies[token] = reply
+	r.mu.Unlock()
+}
+
+// SetDefaultReply sets the answer for device tokens without scripted replies.
+// By default the value is ReplySuccess
+func (r *recorder) SetDefaultReply(reply Reply) {
+	r.mu.Lock()
+	r.defaultReply = reply
+	r.mu.Unlock()
+}
+
+// Requests returns a copy of the received requests list
+func (r *recorder) Requests() []*RecordedRequest {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	retval := make([]*RecordedRequest, len(r.requests))
+	copy(retval, r.requests)
+
+	return retval
+}
+
+// Reset drops the scripted replies and the received requests
+func (r *recorder) Reset() {
+	r.mu.Lock()
+	r.defaultReply = ReplySuccess
+	r.replies = make(map[string]Reply)
+	r.requests = make([]*RecordedRequest, 0)
+	r.mu.Unlock()
+}
+
+func (r *recorder) reply(token string) Reply {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if reply, ok := r.replies[token]; ok {
+		return reply
+	}
+
+	return r.defaultReply
+}
+
+func (r *recorder) record(req *http.Request) ([]byte, error) {
+
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	r.requests = append(r.requests, &RecordedRequest{
+		Path:   req.URL.Path,
+		Header: req.Header.Clone(),
+		Body:   body,
+	})
+	r.mu.Unlock()
+
+	return body, nil
+}
+
+// CertPool returns a set of certificate authorities with the certificate of the server
+func CertPool(srv *httptest.Server) *x509.CertPool {
+
+	pool := x509.NewCertPool()
+	pool.AddCert(srv.Certificate())
+
+	return pool
+}
+
+// CAPem returns the certificate of the server in pem format (a value for 'ca-file')
+func CAPem(srv *httptest.Server) []byte {
+	return pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: srv.Certificate().Raw,
+	})
+}
+
+// SaveTempFile writes data to a new temporary file and returns path to the file
+func SaveTempFile(data []byte, pattern string) (string, error) {
+
+	f, err := ioutil.TempFile("", pattern)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return "", err
+	}
+
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+
+	return f.Name(), nil
+}
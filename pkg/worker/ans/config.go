@@ -1,9 +1,11 @@
 package ans
 
 import (
+	"crypto/tls"
 	"os"
 	"time"
 
+	"github.com/dialogs/dialog-push-service/pkg/provider/ans"
 	"github.com/dialogs/dialog-push-service/pkg/worker"
 	"github.com/pkg/errors"
 	"github.com/spf13/viper"
@@ -13,9 +15,28 @@ type Config struct {
 	*worker.Config `mapstructure:"-"`
 
 	// Path to tls file in pem format
-	PemFile string        `mapstructure:"pem"`
+	PemFile string `mapstructure:"pem"`
+
+	// Token-based authentication (alternative to the pem certificate):
+	// path to the signing key (.p8), key identifier and team identifier
+	KeyFile string `mapstructure:"key-file"`
+	KeyID   string `mapstructure:"key-id"`
+	TeamID  string `mapstructure:"team-id"`
+	// VoIP pushes are allowed (token-based authentication only,
+	// with a certificate the value is read from the certificate)
+	Voip bool `mapstructure:"voip"`
+
 	Retries int           `mapstructure:"retries"`
 	Timeout time.Duration `mapstructure:"timeout"`
+
+	// Base URL of the provider API. By default the public provider endpoint is used
+	Endpoint string `mapstructure:"endpoint"`
+	// Path to CA bundle in pem format. By default the host's root CA set is used
+	CAFile string `mapstructure:"ca-file"`
+
+	// Days before the certificate expiry when the warnings are logged.
+	// By default DefaultExpiryWarningDays
+	ExpiryWarningDays []int `mapstructure:"expiry-warning-days"`
 }
 
 func NewConfig(src *viper.Viper) (*Config, error) {
@@ -31,9 +52,110 @@ func NewConfig(src *viper.Viper) (*Config, error) {
 		return nil, err
 	}
 
-	if _, err := os.Stat(c.PemFile); err != nil {
-		return nil, errors.Wrap(err, "ans: pem")
+	if c.UseToken() {
+		if err := c.checkToken(); err != nil {
+			return nil, err
+		}
+
+	} else if err := c.checkCertificate(); err != nil {
+		return nil, err
+	}
+
+	if c.CAFile != "" {
+		if _, err := os.Stat(c.CAFile); err != nil {
+			return nil, errors.Wrap(err, "ans: ca-file")
+		}
+	}
+
+	for _, days := range c.ExpiryWarningDays {
+		if days <= 0 {
+			return nil, errors.New("ans: invalid `expiry-warning-days`")
+		}
 	}
 
 	return c, nil
 }
+
+// UseToken returns true if the project uses token-based authentication
+func (c *Config) UseToken() bool {
+	return c.KeyFile != ""
+}
+
+// checkCertificate validates the topic by the certificate topics.
+// If the topic isn't set, the topic of the certificate is used
+func (c *Config) checkCertificate() error {
+
+	pem, err := worker.ReadFile(c.PemFile, maxPemSize)
+	if err != nil {
+		return errors.Wrap(err, "ans: pem")
+	}
+
+	cert, err := tls.X509KeyPair(pem, pem)
+	if err != nil {
+		return errors.Wrap(err, "ans: pem")
+	}
+
+	topics, err := ans.GetCertificateTopics(&cert)
+	if err != nil {
+		return errors.Wrap(err, "ans: pem")
+	}
+
+	// the topic isn't validated if the certificate has neither the topics extension nor the subject UID
+	if len(topics) == 0 {
+		return nil
+	}
+
+	supportsVoIP, err := ans.ExistOID(&cert, ans.OidVoIP)
+	if err != nil {
+		return errors.Wrap(err, "ans: pem")
+	}
+
+	if c.Topic == "" {
+		c.Topic = ans.DefaultTopic(topics)
+	}
+
+	if err := ans.CheckTopic(c.Topic, topics, supportsVoIP); err != nil {
+		return errors.Wrap(err, "ans: topic")
+	}
+
+	if supportsVoIP {
+		voipTopic := ans.VoIPTopic(c.Topic)
+		for _, topic := range topics {
+			if topic.Name == voipTopic {
+				c.VoIPTopic = voipTopic
+			}
+		}
+	}
+
+	return nil
+}
+
+func (c *Config) checkToken() error {
+
+	if c.PemFile != "" {
+		return errors.New("ans: 'pem' and 'key-file' can't be used together")
+	}
+
+	if _, err := os.Stat(c.KeyFile); err != nil {
+		return errors.Wrap(err, "ans: key-file")
+	}
+
+	if c.KeyID == "" {
+		return errors.New("ans: invalid `key-id`")
+	}
+
+	if c.TeamID == "" {
+		return errors.New("ans: invalid `team-id`")
+	}
+
+	// apns-topic header is required with token-based authentication
+	if c.Topic == "" {
+		return errors.New("ans: `topic` is required with token-based authentication")
+	}
+
+	if c.Voip {
+		c.VoIPTopic = ans.VoIPTopic(c.Topic)
+	}
+
+	return nil
+}
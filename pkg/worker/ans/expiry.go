@@ -0,0 +1,94 @@
+package ans
+
+import (
+	"sync"
+	"time"
+
+	"github.com/dialogs/dialog-push-service/pkg/metric"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// DefaultExpiryWarningDays are the days before the certificate expiry when the warnings are logged
+var DefaultExpiryWarningDays = []int{30, 7, 1}
+
+var ErrCertificateExpired = errors.New("certificate has expired")
+
+// checkNotAfter returns ErrCertificateExpired if the certificate has expired.
+// The zero time means the certificate isn't used (token-based authentication)
+func checkNotAfter(notAfter, now time.Time) error {
+
+	if notAfter.IsZero() || now.Before(notAfter) {
+		return nil
+	}
+
+	return errors.Wrapf(ErrCertificateExpired, "ans: not after %s", notAfter.Format(time.RFC3339))
+}
+
+// expiry monitors the expiry time of the certificate: the time left is exported
+// to the metric, a warning is logged when the next threshold is passed
+type expiry struct {
+	mu         sync.Mutex
+	notAfter   time.Time
+	thresholds []time.Duration
+	// passed is the count of the passed thresholds
+	passed  int
+	expired bool
+	metric  *metric.CertificateExpiry
+	logger  *zap.Logger
+}
+
+func newExpiry(notAfter time.Time, warningDays []int, expiryMetric *metric.CertificateExpiry, logger *zap.Logger) *expiry {
+
+	if len(warningDays) == 0 {
+		warningDays = DefaultExpiryWarningDays
+	}
+
+	thresholds := make([]time.Duration, 0, len(warningDays))
+	for _, days := range warningDays {
+		thresholds = append(thresholds, time.Duration(days)*24*time.Hour)
+	}
+
+	return &expiry{
+		notAfter:   notAfter,
+		thresholds: thresholds,
+		metric:     expiryMetric,
+		logger:     logger,
+	}
+}
+
+// check updates the metric and logs the expiry. Returns ErrCertificateExpired
+// if the certificate has expired
+func (e *expiry) check(now time.Time) error {
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	left := e.notAfter.Sub(now)
+	e.metric.Set(left)
+
+	if left <= 0 {
+		if !e.expired {
+			e.expired = true
+			e.logger.Error(ErrCertificateExpired.Error(), zap.Time("not after", e.notAfter))
+		}
+
+		return ErrCertificateExpired
+	}
+
+	passed := 0
+	for _, threshold := range e.thresholds {
+		if left <= threshold {
+			passed++
+		}
+	}
+
+	if passed > e.passed {
+		e.logger.Warn("certificate expires soon",
+			zap.Time("not after", e.notAfter),
+			zap.Duration("left", left.Truncate(time.Minute)))
+	}
+	e.passed = passed
+
+	return nil
+}
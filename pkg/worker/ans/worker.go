@@ -5,6 +5,7 @@ import (
 	"errors"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/dialogs/dialog-push-service/pkg/metric"
 	"github.com/dialogs/dialog-push-service/pkg/provider"
@@ -15,20 +16,26 @@ import (
 
 var ErrInvalidRequestType = errors.New("invalid apns request type")
 
+// maxPemSize is the max size of the certificate file
+const maxPemSize = 1024 * 1024 * 10
+
 type Worker struct {
 	*worker.Worker
 	provider *ans.Client
+	// expiry monitors the certificate. Nil if the provider uses token-based authentication
+	expiry *expiry
 }
 
 func New(cfg *Config, logger *zap.Logger, svcMetric *metric.Service) (*Worker, error) {
 
-	pem, err := worker.ReadFile(cfg.PemFile, 1024*1024*10)
+	provider, err := newProvider(cfg)
 	if err != nil {
 		return nil, err
 	}
 
-	provider, err := ans.NewFromPem(pem, cfg.Sandbox, cfg.Retries, cfg.Timeout)
-	if err != nil {
+	notAfter := provider.NotAfter()
+	if err := checkNotAfter(notAfter, time.Now()); err != nil {
+		provider.Close()
 		return nil, err
 	}
 
@@ -45,40 +52,118 @@ func New(cfg *Config, logger *zap.Logger, svcMetric *metric.Service) (*Worker, e
 		w.sendNotification,
 	)
 	if err != nil {
+		provider.Close()
 		return nil, err
 	}
 
+	if !notAfter.IsZero() {
+		// the gauge is exported only by the projects with a certificate
+		expiryMetric, err := svcMetric.GetCertificateExpiryMetric(worker.KindApns.String(), cfg.ProjectID)
+		if err != nil {
+			provider.Close()
+			return nil, err
+		}
+
+		l := logger.With(
+			zap.String("worker", worker.KindApns.String()),
+			zap.String("project ID", cfg.ProjectID))
+
+		w.expiry = newExpiry(notAfter, cfg.ExpiryWarningDays, expiryMetric, l)
+		w.expiry.check(time.Now())
+	}
+
 	return w, nil
 }
 
+func newProvider(cfg *Config) (*ans.Client, error) {
+
+	rootCAs, err := worker.NewCertPool(cfg.CAFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.UseToken() {
+		key, err := worker.ReadFile(cfg.KeyFile, 1024*1024)
+		if err != nil {
+			return nil, err
+		}
+
+		token, err := ans.NewToken(key, cfg.KeyID, cfg.TeamID)
+		if err != nil {
+			return nil, err
+		}
+
+		return ans.NewFromToken(token, cfg.Voip, cfg.Sandbox, cfg.RetryPolicy(cfg.Retries), cfg.Timeout, cfg.Endpoint, rootCAs)
+	}
+
+	pem, err := worker.ReadFile(cfg.PemFile, maxPemSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return ans.NewFromPem(pem, cfg.Sandbox, cfg.RetryPolicy(cfg.Retries), cfg.Timeout, cfg.Endpoint, rootCAs)
+}
+
 func (w *Worker) SupportsVoIP() bool {
 	return w.provider.SupportsVoIP()
 }
 
-func (w *Worker) sendNotification(ctx context.Context, in provider.IRequest) error {
+// Health returns ErrCertificateExpired if the certificate has expired,
+// otherwise the health of the worker
+func (w *Worker) Health() error {
+
+	if w.expiry != nil {
+		if err := w.expiry.check(time.Now()); err != nil {
+			return err
+		}
+	}
+
+	return w.Worker.Health()
+}
+
+// Close closes the connections of the provider
+func (w *Worker) Close() {
+	w.provider.Close()
+}
+
+// NewRequest returns an empty notification of the provider
+func (w *Worker) NewRequest() provider.IRequest {
+	return &ans.Request{}
+}
+
+func (w *Worker) sendNotification(ctx context.Context, in provider.IRequest) (*worker.Result, error) {
 
 	req, ok := in.(*ans.Request)
 	if !ok || req == nil {
-		return ErrInvalidRequestType
+		return nil, ErrInvalidRequestType
 	}
 
 	answer, err := w.provider.Send(ctx, req)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	result := &worker.Result{
+		MessageID: answer.ID,
+	}
 
-	} else if answer.StatusCode != 200 {
+	if answer.StatusCode != 200 {
 		msg := answer.Body.Reason
 		if msg == "" {
 			msg = http.StatusText(answer.StatusCode)
 		}
 
 		err := errors.New(strconv.Itoa(answer.StatusCode) + " " + msg)
-		if answer.StatusCode == http.StatusBadRequest && answer.Body.Reason == "BadDeviceToken" {
-			return worker.NewResponseErrorBadDeviceToken(err)
+		switch {
+		case answer.StatusCode == http.StatusBadRequest && answer.Body.Reason == ans.ReasonBadDeviceToken:
+			return result, worker.NewResponseErrorBadDeviceToken(err)
+
+		case answer.StatusCode == http.StatusGone:
+			return result, worker.NewResponseErrorUnregistered(err, answer.Body.GetTimestamp())
 		}
 
-		return worker.NewResponseErrorFromAnswer(answer.StatusCode, err)
+		return result, worker.NewResponseErrorFromAnswer(answer.StatusCode, err)
 	}
 
-	return nil
+	return result, nil
 }
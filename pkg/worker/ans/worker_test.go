@@ -3,7 +3,11 @@ package ans
 import (
 	"context"
 	"errors"
+	"log"
+	"os"
+	"sort"
 	"testing"
+	"time"
 
 	"github.com/dialogs/dialog-push-service/pkg/metric"
 	"github.com/dialogs/dialog-push-service/pkg/provider/ans"
@@ -13,10 +17,50 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
 )
 
 var payload = []byte(`{"aps":{"title":"title"}}`)
 
+// messageID is the apns-id of the sent notifications
+const messageID = "CDB997A0-0C7C-8E2E-DBB5-13E89D5C756E"
+
+var (
+	apnsServer *test.APNsServer
+	pemFile    string
+	caFile     string
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(runTests(m))
+}
+
+func runTests(m *testing.M) int {
+
+	apnsServer = test.NewAPNsServer()
+	defer apnsServer.Close()
+
+	pem, err := test.NewAppleCertificatePem(test.AppleCertificate{
+		Production: true,
+		Topics:     []string{"im.dlg.test"},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for path, data := range map[*string][]byte{
+		&pemFile: pem,
+		&caFile:  test.CAPem(apnsServer.Server),
+	} {
+		if *path, err = test.SaveTempFile(data, "apns"); err != nil {
+			log.Fatal(err)
+		}
+		defer os.Remove(*path)
+	}
+
+	return m.Run()
+}
+
 func TestWokerNew(t *testing.T) {
 
 	cfg := getConfig(t)
@@ -38,7 +82,8 @@ func TestWokerSendErrInvalidDeviceToken(t *testing.T) {
 	w, err := New(cfg, logger, metric.New())
 	require.NoError(t, err)
 
-	chOut := w.Send(context.Background(), &worker.Request{})
+	chOut, err := w.Send(context.Background(), &worker.Request{})
+	require.NoError(t, err)
 
 	require.Equal(t,
 		&worker.Response{
@@ -59,73 +104,333 @@ func TestWokerSendNopOk(t *testing.T) {
 	w, err := New(cfg, logger, metric.New())
 	require.NoError(t, err)
 
-	chOut := w.Send(context.Background(), &worker.Request{
+	chOut, err := w.Send(context.Background(), &worker.Request{
 		Devices: []string{"token1", "token2"},
 		Payload: &ans.Request{Payload: payload},
 	})
+	require.NoError(t, err)
 
 	require.Equal(t,
-		&worker.Response{
-			ProjectID:   w.ProjectID(),
-			DeviceToken: "token1",
-		},
-		<-chOut)
-
-	require.Equal(t,
-		&worker.Response{
-			ProjectID:   w.ProjectID(),
-			DeviceToken: "token2",
+		[]*worker.Response{
+			{
+				ProjectID:   w.ProjectID(),
+				DeviceToken: "token1",
+			},
+			{
+				ProjectID:   w.ProjectID(),
+				DeviceToken: "token2",
+			},
 		},
-		<-chOut)
-
-	_, ok := <-chOut
-	require.False(t, ok)
+		readResponses(chOut))
 }
 
 func TestWokerSendOk(t *testing.T) {
 
 	cfg := getConfig(t)
 	logger := getLogger(t)
-	token := getDeviceToken(t)
+	token := "token1"
 
 	cfg.NopMode = false
 
+	apnsServer.Reset()
+	apnsServer.SetReply("token2", test.ReplyBadDeviceToken)
+
 	w, err := New(cfg, logger, metric.New())
 	require.NoError(t, err)
 
-	chOut := w.Send(context.Background(), &worker.Request{
+	chOut, err := w.Send(context.Background(), &worker.Request{
 		Devices: []string{token, "token2", token},
-		Payload: &ans.Request{Payload: payload},
+		Payload: &ans.Request{Headers: ans.RequestHeader{ID: messageID}, Payload: payload},
 	})
+	require.NoError(t, err)
 
-	require.Equal(t,
-		&worker.Response{
-			ProjectID:   w.ProjectID(),
-			DeviceToken: token,
-		},
-		<-chOut)
+	responses := readResponses(chOut)
+	require.Len(t, responses, 3)
+
+	for _, res := range responses[:2] {
+		require.Equal(t,
+			&worker.Response{
+				ProjectID:   w.ProjectID(),
+				DeviceToken: token,
+				MessageID:   messageID,
+			},
+			res)
+	}
 
 	apnsError := errors.New("400 BadDeviceToken")
-	res := <-chOut
+	res := responses[2]
 	require.Equal(t, apnsError, res.Error.(*worker.ResponseError).Err())
 	require.Equal(t, worker.NewResponseErrorBadDeviceToken(apnsError), res.Error)
 	require.Equal(t,
 		&worker.Response{
 			ProjectID:   w.ProjectID(),
 			DeviceToken: "token2",
+			MessageID:   messageID,
 			Error:       worker.NewResponseErrorBadDeviceToken(apnsError),
 		},
 		res)
 
+	requests := apnsServer.Requests()
+	paths := make([]string, 0, len(requests))
+	for _, req := range requests {
+		paths = append(paths, req.Path)
+		require.JSONEq(t, string(payload), string(req.Body))
+	}
+
+	require.ElementsMatch(t,
+		[]string{"/3/device/" + token, "/3/device/token2", "/3/device/" + token},
+		paths)
+}
+
+func TestWokerSendUnregistered(t *testing.T) {
+
+	cfg := getConfig(t)
+	cfg.NopMode = false
+
+	apnsServer.Reset()
+	apnsServer.SetReply("token1", test.ReplyUnregistered)
+
+	w, err := New(cfg, getLogger(t), metric.New())
+	require.NoError(t, err)
+
+	chOut, err := w.Send(context.Background(), &worker.Request{
+		Devices: []string{"token1"},
+		Payload: &ans.Request{Payload: payload},
+	})
+	require.NoError(t, err)
+
+	res := <-chOut
+	resErr, ok := res.Error.(*worker.ResponseError)
+	require.True(t, ok)
+	require.Equal(t, worker.ErrorCodeUnregistered, resErr.Code)
+	require.EqualError(t, resErr.Err(), "410 Unregistered")
+	require.WithinDuration(t, time.Now(), resErr.Timestamp, time.Minute)
+
+	_, ok = <-chOut
+	require.False(t, ok)
+}
+
+func TestWokerSendWithToken(t *testing.T) {
+
+	key, err := test.NewAuthKeyPem()
+	require.NoError(t, err)
+
+	keyFile, err := test.SaveTempFile(key, "apns")
+	require.NoError(t, err)
+	defer func() { require.NoError(t, os.Remove(keyFile)) }()
+
+	src := viper.New()
+	for k, v := range map[string]interface{}{
+		"project-id": "project-id-123",
+		"key-file":   keyFile,
+		"key-id":     "key-id",
+		"team-id":    "team-id",
+		"topic":      "im.dlg.test",
+		"voip":       "true",
+		"endpoint":   apnsServer.URL,
+		"ca-file":    caFile,
+	} {
+		src.Set(k, v)
+	}
+
+	cfg, err := NewConfig(src)
+	require.NoError(t, err)
+	require.Equal(t, "im.dlg.test.voip", cfg.VoIPTopic)
+
+	apnsServer.Reset()
+
+	w, err := New(cfg, getLogger(t), metric.New())
+	require.NoError(t, err)
+	require.True(t, w.SupportsVoIP())
+	require.NotNil(t, w.provider.Token())
+
+	chOut, err := w.Send(context.Background(), &worker.Request{
+		Devices: []string{"token1"},
+		Payload: &ans.Request{Headers: ans.RequestHeader{ID: messageID}, Payload: payload},
+	})
+	require.NoError(t, err)
+
 	require.Equal(t,
 		&worker.Response{
 			ProjectID:   w.ProjectID(),
-			DeviceToken: token,
+			DeviceToken: "token1",
+			MessageID:   messageID,
 		},
 		<-chOut)
 
 	_, ok := <-chOut
 	require.False(t, ok)
+
+	bearer, err := w.provider.Token().Bearer()
+	require.NoError(t, err)
+
+	requests := apnsServer.Requests()
+	require.Len(t, requests, 1)
+	require.Equal(t, "bearer "+bearer, requests[0].Header.Get("authorization"))
+}
+
+func TestConfigTopic(t *testing.T) {
+
+	newConfig := func(topic string) (*Config, error) {
+		t.Helper()
+
+		src := viper.New()
+		src.Set("project-id", "project-id-123")
+		src.Set("pem", pemFile)
+		src.Set("topic", topic)
+
+		return NewConfig(src)
+	}
+
+	// the topic of the certificate
+	cfg, err := newConfig("")
+	require.NoError(t, err)
+	require.Equal(t, "im.dlg.test", cfg.Topic)
+
+	cfg, err = newConfig("im.dlg.test")
+	require.NoError(t, err)
+	require.Equal(t, "im.dlg.test", cfg.Topic)
+
+	for _, topic := range []string{"im.dlg.dialog-ee", "im.dlg.test.voip"} {
+		_, err = newConfig(topic)
+		require.EqualError(t, err, "ans: topic: invalid topic: '"+topic+"' (topics in certificate: [im.dlg.test])")
+	}
+
+	voipPem, err := test.NewAppleCertificatePem(test.AppleCertificate{
+		Production: true,
+		Topics:     []string{"im.dlg.test", "im.dlg.test.voip"},
+	})
+	require.NoError(t, err)
+
+	voipPemFile, err := test.SaveTempFile(voipPem, "apns")
+	require.NoError(t, err)
+	defer func() { require.NoError(t, os.Remove(voipPemFile)) }()
+
+	src := viper.New()
+	src.Set("project-id", "project-id-123")
+	src.Set("pem", voipPemFile)
+	src.Set("topic", "im.dlg.test.voip")
+
+	_, err = NewConfig(src)
+	require.EqualError(t, err, "ans: topic: VoIP topic 'im.dlg.test.voip' on a non-VoIP certificate")
+
+	newCertConfig := func(cert test.AppleCertificate, topic string) (*Config, error) {
+		t.Helper()
+
+		pem, err := test.NewAppleCertificatePem(cert)
+		require.NoError(t, err)
+
+		file, err := test.SaveTempFile(pem, "apns")
+		require.NoError(t, err)
+		defer func() { require.NoError(t, os.Remove(file)) }()
+
+		src := viper.New()
+		src.Set("project-id", "project-id-123")
+		src.Set("pem", file)
+		src.Set("topic", topic)
+
+		return NewConfig(src)
+	}
+
+	// VoIP certificate: the app topic by default, the .voip topic for the VoIP pushes
+	voipCert := test.AppleCertificate{
+		Production: true,
+		VoIP:       true,
+		Topics:     []string{"im.dlg.test", "im.dlg.test.voip"},
+	}
+
+	cfg, err = newCertConfig(voipCert, "")
+	require.NoError(t, err)
+	require.Equal(t, "im.dlg.test", cfg.Topic)
+	require.Equal(t, "im.dlg.test.voip", cfg.VoIPTopic)
+
+	cfg, err = newCertConfig(voipCert, "im.dlg.test.voip")
+	require.NoError(t, err)
+	require.Equal(t, "im.dlg.test.voip", cfg.Topic)
+	require.Equal(t, "im.dlg.test.voip", cfg.VoIPTopic)
+
+	// the certificate without the topics extension: the topic is the subject UID
+	uidCert := test.AppleCertificate{Production: true, VoIP: true, UID: "im.dlg.legacy"}
+
+	cfg, err = newCertConfig(uidCert, "")
+	require.NoError(t, err)
+	require.Equal(t, "im.dlg.legacy", cfg.Topic)
+	require.Empty(t, cfg.VoIPTopic)
+
+	_, err = newCertConfig(uidCert, "im.dlg.test")
+	require.EqualError(t, err, "ans: topic: invalid topic: 'im.dlg.test' (topics in certificate: [im.dlg.legacy])")
+}
+
+func TestWorkerCertificateExpiry(t *testing.T) {
+
+	newConfig := func(notAfter time.Time) *Config {
+		t.Helper()
+
+		pem, err := test.NewAppleCertificatePem(test.AppleCertificate{Production: true, NotAfter: notAfter})
+		require.NoError(t, err)
+
+		file, err := test.SaveTempFile(pem, "apns")
+		require.NoError(t, err)
+
+		cfg := getConfig(t)
+		cfg.PemFile = file
+
+		return cfg
+	}
+
+	t.Run("expired", func(t *testing.T) {
+		notAfter := time.Now().Add(-time.Hour).UTC().Truncate(time.Second)
+		cfg := newConfig(notAfter)
+		defer func() { require.NoError(t, os.Remove(cfg.PemFile)) }()
+
+		_, err := New(cfg, getLogger(t), metric.New())
+		require.EqualError(t, err, "ans: not after "+notAfter.Format(time.RFC3339)+": "+ErrCertificateExpired.Error())
+	})
+
+	t.Run("health", func(t *testing.T) {
+		cfg := newConfig(time.Now().Add(time.Hour))
+		defer func() { require.NoError(t, os.Remove(cfg.PemFile)) }()
+
+		w, err := New(cfg, getLogger(t), metric.New())
+		require.NoError(t, err)
+		require.NoError(t, w.Health())
+
+		w.expiry.notAfter = time.Now().Add(-time.Second)
+		require.Equal(t, ErrCertificateExpired, w.Health())
+	})
+}
+
+func TestExpiryCheck(t *testing.T) {
+
+	svcMetric := metric.New()
+	expiryMetric, err := svcMetric.GetCertificateExpiryMetric(worker.KindApns.String(), "project-id-123")
+	require.NoError(t, err)
+
+	core, logs := observer.New(zapcore.InfoLevel)
+
+	notAfter := time.Now()
+	e := newExpiry(notAfter, nil, expiryMetric, zap.New(core))
+
+	day := 24 * time.Hour
+	for _, testCase := range []struct {
+		Left     time.Duration
+		Err      error
+		Warnings int
+	}{
+		{Left: 40 * day},
+		{Left: 29 * day, Warnings: 1},
+		{Left: 28 * day, Warnings: 1},
+		{Left: 6 * day, Warnings: 2},
+		{Left: 12 * time.Hour, Warnings: 3},
+		{Left: 0, Err: ErrCertificateExpired, Warnings: 3},
+		{Left: -day, Err: ErrCertificateExpired, Warnings: 3},
+	} {
+		require.Equal(t, testCase.Err, e.check(notAfter.Add(-testCase.Left)), testCase.Left)
+		require.Equal(t, testCase.Warnings, logs.FilterMessage("certificate expires soon").Len(), testCase.Left)
+	}
+
+	// the expiry is logged once
+	require.Equal(t, 1, logs.FilterMessage(ErrCertificateExpired.Error()).Len())
 }
 
 func getLogger(t *testing.T) *zap.Logger {
@@ -145,7 +450,9 @@ func getConfig(t *testing.T) *Config {
 	src := viper.New()
 	for k, v := range map[string]interface{}{
 		"project-id": "project-id-123",
-		"pem":        getPathToCertificatePem(t),
+		"pem":        pemFile,
+		"endpoint":   apnsServer.URL,
+		"ca-file":    caFile,
 		"nop-mode":   "true",
 		"workers":    "-1",
 	} {
@@ -158,20 +465,18 @@ func getConfig(t *testing.T) *Config {
 	return c
 }
 
-func getPathToCertificatePem(t *testing.T) string {
-	t.Helper()
-
-	path, err := test.GetPathToIOSCertificatePem()
-	require.NoError(t, err)
-
-	return path
-}
+// readResponses reads all responses sorted by device token:
+// the worker sends to the devices concurrently
+func readResponses(chOut <-chan *worker.Response) []*worker.Response {
 
-func getDeviceToken(t *testing.T) string {
-	t.Helper()
+	retval := make([]*worker.Response, 0)
+	for res := range chOut {
+		retval = append(retval, res)
+	}
 
-	_, token, err := test.GetPushDevices()
-	require.NoError(t, err)
+	sort.SliceStable(retval, func(i, j int) bool {
+		return retval[i].DeviceToken < retval[j].DeviceToken
+	})
 
-	return token
+	return retval
 }
@@ -0,0 +1,161 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/dialogs/dialog-go-lib/enum"
+	"github.com/pkg/errors"
+)
+
+const (
+	DefaultBreakerFailures = 10
+	DefaultBreakerTimeout  = 30 * time.Second
+)
+
+var ErrCircuitOpen = NewResponseError(ErrorCodeCircuitOpen, errors.New("circuit breaker is open: provider is unavailable"))
+
+const (
+	BreakerClosed   BreakerState = 0
+	BreakerOpen     BreakerState = 1
+	BreakerHalfOpen BreakerState = 2
+)
+
+type BreakerState int
+
+var _BreakerStateEnum = enum.New("breaker state").
+	Add(BreakerClosed, "closed").     // the notifications are sent
+	Add(BreakerOpen, "open").         // the notifications fail fast
+	Add(BreakerHalfOpen, "half-open") // a probe notification is sent
+
+func (s BreakerState) String() string {
+	val, ok := _BreakerStateEnum.GetByIndex(s)
+	if !ok {
+		return fmt.Sprintf("invalid breaker state: %d", s)
+	}
+
+	return val
+}
+
+// breaker is a circuit breaker of the provider. The breaker is opened by
+// consecutive provider failures. After the timeout one probe notification
+// is sent: the breaker is closed if the probe is sent successfully
+type breaker struct {
+	mu        sync.Mutex
+	threshold int
+	timeout   time.Duration
+	state     BreakerState
+	failures  int
+	openedAt  time.Time
+	// probe is true while the probe notification is sent
+	probe    bool
+	now      func() time.Time
+	onChange func(from, to BreakerState)
+}
+
+func newBreaker(threshold int, timeout time.Duration, onChange func(from, to BreakerState)) *breaker {
+	return &breaker{
+		threshold: threshold,
+		timeout:   timeout,
+		now:       time.Now,
+		onChange:  onChange,
+	}
+}
+
+// allow returns false if the notification must fail fast
+func (b *breaker) allow() bool {
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case BreakerOpen:
+		if b.now().Sub(b.openedAt) < b.timeout {
+			return false
+		}
+
+		b.setState(BreakerHalfOpen)
+		b.probe = true
+		return true
+
+	case BreakerHalfOpen:
+		if b.probe {
+			return false
+		}
+
+		b.probe = true
+		return true
+	}
+
+	return true
+}
+
+// currentState returns the state of the breaker. The open breaker is half-open after
+// the timeout: the probe is sent by the next notification, the state isn't changed
+func (b *breaker) currentState() BreakerState {
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == BreakerOpen && b.now().Sub(b.openedAt) >= b.timeout {
+		return BreakerHalfOpen
+	}
+
+	return b.state
+}
+
+// done registers the result of the allowed notification
+func (b *breaker) done(ctx context.Context, err error) {
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if ctx.Err() != nil {
+		// the notification is canceled by the caller
+		if b.state == BreakerHalfOpen {
+			b.probe = false
+		}
+		return
+	}
+
+	failed := isProviderFailure(err)
+
+	switch b.state {
+	case BreakerClosed:
+		if !failed {
+			b.failures = 0
+			return
+		}
+
+		b.failures++
+		if b.failures >= b.threshold {
+			b.open()
+		}
+
+	case BreakerHalfOpen:
+		b.probe = false
+		if failed {
+			b.open()
+		} else {
+			b.failures = 0
+			b.setState(BreakerClosed)
+		}
+	}
+}
+
+func (b *breaker) open() {
+	b.openedAt = b.now()
+	b.setState(BreakerOpen)
+}
+
+func (b *breaker) setState(state BreakerState) {
+
+	from := b.state
+	b.state = state
+
+	if b.onChange != nil && from != state {
+		b.onChange(from, state)
+	}
+}
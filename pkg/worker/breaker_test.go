@@ -0,0 +1,81 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBreaker(t *testing.T) {
+
+	now := time.Now()
+	changes := make([]BreakerState, 0)
+
+	b := newBreaker(2, time.Minute, func(_, to BreakerState) {
+		changes = append(changes, to)
+	})
+	b.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	unavailable := NewResponseErrorFromAnswer(http.StatusServiceUnavailable, errors.New("unavailable"))
+
+	// the failures aren't consecutive
+	require.True(t, b.allow())
+	b.done(ctx, unavailable)
+	require.True(t, b.allow())
+	b.done(ctx, nil)
+	require.True(t, b.allow())
+	b.done(ctx, unavailable)
+	require.Equal(t, BreakerClosed, b.state)
+
+	// the invalid token isn't a provider failure
+	require.True(t, b.allow())
+	b.done(ctx, NewResponseErrorBadDeviceToken(errors.New("BadDeviceToken")))
+	require.Equal(t, BreakerClosed, b.state)
+
+	for i := 0; i < 2; i++ {
+		require.True(t, b.allow())
+		b.done(ctx, unavailable)
+	}
+	require.Equal(t, BreakerOpen, b.state)
+	require.Equal(t, BreakerOpen, b.currentState())
+	require.False(t, b.allow())
+
+	// the probe is allowed after the timeout without the notifications
+	now = now.Add(time.Minute)
+	require.Equal(t, BreakerHalfOpen, b.currentState())
+
+	// the probe fails
+	require.True(t, b.allow())
+	require.False(t, b.allow())
+	b.done(ctx, unavailable)
+	require.Equal(t, BreakerOpen, b.state)
+	require.False(t, b.allow())
+
+	// the canceled probe doesn't change the state
+	now = now.Add(time.Minute)
+	require.True(t, b.allow())
+	canceledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	b.done(canceledCtx, context.Canceled)
+	require.Equal(t, BreakerHalfOpen, b.state)
+
+	// the probe is sent successfully
+	require.True(t, b.allow())
+	b.done(ctx, nil)
+	require.Equal(t, BreakerClosed, b.state)
+
+	require.Equal(t,
+		[]BreakerState{
+			BreakerOpen,
+			BreakerHalfOpen,
+			BreakerOpen,
+			BreakerHalfOpen,
+			BreakerClosed,
+		},
+		changes)
+}
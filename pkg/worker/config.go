@@ -1,7 +1,10 @@
 package worker
 
 import (
+	"time"
+
 	"github.com/dialogs/dialog-push-service/pkg/conversion"
+	"github.com/dialogs/dialog-push-service/pkg/provider"
 	"github.com/pkg/errors"
 	"github.com/spf13/viper"
 )
@@ -12,6 +15,31 @@ type Config struct {
 	NopMode            bool   `mapstructure:"nop-mode"`
 	CountThreads       int    `mapstructure:"workers"`
 	Sandbox            bool   `mapstructure:"sandbox"`
+
+	// QueueSize is max count of requests in the worker: the requests wait for the sending threads.
+	// A new request waits for a place in the queue until QueueTimeout ends
+	QueueSize    int           `mapstructure:"queue-size"`
+	QueueTimeout time.Duration `mapstructure:"queue-timeout"`
+
+	// Threads reserved for the requests with high and normal priority in addition to CountThreads
+	HighPriorityThreads   int `mapstructure:"high-priority-workers"`
+	NormalPriorityThreads int `mapstructure:"normal-priority-workers"`
+
+	// RateLimit is max count of messages per second to the provider. The limit is disabled if the value is zero
+	RateLimit float64 `mapstructure:"rate-limit"`
+	RateBurst int     `mapstructure:"rate-burst"`
+
+	// BreakerFailures is count of consecutive provider failures (transport errors, 5xx) to open
+	// the circuit breaker. The notifications fail fast until BreakerTimeout ends
+	BreakerFailures int           `mapstructure:"breaker-failures"`
+	BreakerTimeout  time.Duration `mapstructure:"breaker-timeout"`
+
+	// Retry policy of the provider requests (see provider.RetryPolicy).
+	// The default value is used if an option is zero
+	RetryBaseDelay  time.Duration `mapstructure:"retry-base-delay"`
+	RetryMaxDelay   time.Duration `mapstructure:"retry-max-delay"`
+	RetryJitter     float64       `mapstructure:"retry-jitter"`
+	RetryMaxElapsed time.Duration `mapstructure:"retry-max-elapsed"`
 }
 
 func NewConfig(src *viper.Viper) (*Config, error) {
@@ -31,5 +59,69 @@ func NewConfig(src *viper.Viper) (*Config, error) {
 		return nil, errors.New("invalid `project-id`")
 	}
 
+	if c.QueueSize < 0 {
+		return nil, errors.New("invalid `queue-size`")
+	}
+
+	if c.QueueTimeout < 0 {
+		return nil, errors.New("invalid `queue-timeout`")
+	}
+
+	if c.HighPriorityThreads < 0 {
+		return nil, errors.New("invalid `high-priority-workers`")
+	}
+
+	if c.NormalPriorityThreads < 0 {
+		return nil, errors.New("invalid `normal-priority-workers`")
+	}
+
+	if c.RateLimit < 0 {
+		return nil, errors.New("invalid `rate-limit`")
+	}
+
+	if c.RateBurst < 0 {
+		return nil, errors.New("invalid `rate-burst`")
+	}
+
+	if c.BreakerFailures < 0 {
+		return nil, errors.New("invalid `breaker-failures`")
+	}
+
+	if c.BreakerTimeout < 0 {
+		return nil, errors.New("invalid `breaker-timeout`")
+	}
+
+	if c.RetryJitter < 0 || c.RetryJitter > 1 {
+		return nil, errors.New("invalid `retry-jitter`: the value must be from 0 to 1")
+	}
+
 	return c, nil
 }
+
+// RetryPolicy returns the retry policy of the provider requests
+func (c *Config) RetryPolicy(maxRetries int) *provider.RetryPolicy {
+
+	policy := provider.NewRetryPolicy(maxRetries)
+
+	if c.RetryBaseDelay > 0 {
+		policy.BaseDelay = c.RetryBaseDelay
+	}
+
+	if c.RetryMaxDelay > 0 {
+		policy.MaxDelay = c.RetryMaxDelay
+	}
+
+	if c.RetryJitter > 0 {
+		policy.Jitter = c.RetryJitter
+	}
+
+	if c.RetryMaxElapsed > 0 {
+		policy.MaxElapsed = c.RetryMaxElapsed
+	}
+
+	if policy.BaseDelay > policy.MaxDelay {
+		policy.MaxDelay = policy.BaseDelay
+	}
+
+	return policy
+}
@@ -17,6 +17,13 @@ type Config struct {
 	ServiceAccount string        `mapstructure:"service-account"`
 	Retries        int           `mapstructure:"retries"`
 	Timeout        time.Duration `mapstructure:"timeout"`
+
+	// Base URL of the provider API. By default the public provider endpoint is used
+	Endpoint string `mapstructure:"endpoint"`
+	// Path to CA bundle in pem format. By default the host's root CA set is used
+	CAFile string `mapstructure:"ca-file"`
+	// OAuth token URL. By default the value is read from the service account
+	TokenEndpoint string `mapstructure:"token-endpoint"`
 }
 
 func NewConfig(src *viper.Viper) (*Config, error) {
@@ -36,5 +43,11 @@ func NewConfig(src *viper.Viper) (*Config, error) {
 		return nil, errors.Wrap(err, "path to service-account")
 	}
 
+	if c.CAFile != "" {
+		if _, err := os.Stat(c.CAFile); err != nil {
+			return nil, errors.Wrap(err, "fcm: ca-file")
+		}
+	}
+
 	return c, nil
 }
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"errors"
 	"io"
+	"time"
 
 	"github.com/dialogs/dialog-push-service/pkg/metric"
 	"github.com/dialogs/dialog-push-service/pkg/provider"
@@ -28,7 +29,20 @@ func New(cfg *Config, logger *zap.Logger, svcMetric *metric.Service) (*Worker, e
 		return nil, err
 	}
 
-	provider, err := fcm.New(serviceAccount, cfg.Sandbox, cfg.Retries, cfg.Timeout)
+	rootCAs, err := worker.NewCertPool(cfg.CAFile)
+	if err != nil {
+		return nil, err
+	}
+
+	provider, err := fcm.New(
+		serviceAccount,
+		cfg.Sandbox,
+		cfg.RetryPolicy(cfg.Retries),
+		cfg.Timeout,
+		cfg.Endpoint,
+		cfg.TokenEndpoint,
+		rootCAs,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -56,33 +70,52 @@ func (w *Worker) SupportsVoIP() bool {
 	return false
 }
 
-func (w *Worker) sendNotification(ctx context.Context, in provider.IRequest) error {
+// Close closes the connections of the provider
+func (w *Worker) Close() {
+	w.provider.Close()
+}
+
+// NewRequest returns an empty notification of the provider
+func (w *Worker) NewRequest() provider.IRequest {
+	return &fcm.Message{}
+}
+
+func (w *Worker) sendNotification(ctx context.Context, in provider.IRequest) (*worker.Result, error) {
 
 	req, ok := in.(*fcm.Message)
 	if !ok || req == nil {
-		return ErrInvalidRequestType
+		return nil, ErrInvalidRequestType
 	}
 
 	answer, err := w.provider.Send(ctx, req)
 	if err != nil {
-		return err
+		return nil, err
 
 	} else if answer.Error != nil {
-		if answer.Error.Code == 400 && answer.Error.Status == fcm.ErrorCodeInvalidArgument {
-
+		switch {
+		case answer.Error.Code == 400 && answer.Error.Status == fcm.ErrorCodeInvalidArgument:
 			fields := getStringValueFromJSON(answer.Error.Details, "field")
 			for i := range fields {
 				if fields[i] == "message.token" {
-					return worker.NewResponseErrorBadDeviceToken(answer.Error)
+					return nil, worker.NewResponseErrorBadDeviceToken(answer.Error)
 				}
 			}
+
+			return nil, worker.NewResponseError(worker.ErrorCodeBadRequest, answer.Error)
+
+		case answer.Error.FcmErrorCode() == fcm.ErrorCodeUnregistered:
+			// a plain 404 (NOT_FOUND) is an invalid project or endpoint, not the device token
+			return nil, worker.NewResponseErrorUnregistered(answer.Error, time.Time{})
+
+		case answer.StatusCode == 429 || answer.Error.FcmErrorCode() == fcm.ErrorCodeQuotaExceeded:
+			return nil, worker.NewResponseErrorThrottled(answer.Error)
 		}
 
-		return answer.Error
+		return nil, answer.Error
 
 	}
 
-	return nil
+	return &worker.Result{MessageID: answer.Name}, nil
 }
 
 func getStringValueFromJSON(src json.RawMessage, key string) []string {
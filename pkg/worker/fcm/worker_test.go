@@ -3,6 +3,9 @@ package fcm
 import (
 	"context"
 	"encoding/json"
+	"log"
+	"os"
+	"sort"
 	"testing"
 
 	"github.com/dialogs/dialog-push-service/pkg/metric"
@@ -15,6 +18,39 @@ import (
 	"go.uber.org/zap/zapcore"
 )
 
+var (
+	fcmServer          *test.FCMServer
+	serviceAccountFile string
+	caFile             string
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(runTests(m))
+}
+
+func runTests(m *testing.M) int {
+
+	fcmServer = test.NewFCMServer("fcm-project")
+	defer fcmServer.Close()
+
+	serviceAccount, err := fcmServer.ServiceAccount()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for path, data := range map[*string][]byte{
+		&serviceAccountFile: serviceAccount,
+		&caFile:             test.CAPem(fcmServer.Server),
+	} {
+		if *path, err = test.SaveTempFile(data, "fcm"); err != nil {
+			log.Fatal(err)
+		}
+		defer os.Remove(*path)
+	}
+
+	return m.Run()
+}
+
 func TestWokerNew(t *testing.T) {
 
 	cfg := getConfig(t)
@@ -36,7 +72,8 @@ func TestWokerSendErrInvalidDeviceToken(t *testing.T) {
 	w, err := New(cfg, logger, metric.New())
 	require.NoError(t, err)
 
-	chOut := w.Send(context.Background(), &worker.Request{})
+	chOut, err := w.Send(context.Background(), &worker.Request{})
+	require.NoError(t, err)
 	require.Equal(t,
 		&worker.Response{
 			ProjectID: w.ProjectID(),
@@ -56,73 +93,67 @@ func TestWokerSendNopOk(t *testing.T) {
 	w, err := New(cfg, logger, metric.New())
 	require.NoError(t, err)
 
-	chOut := w.Send(context.Background(), &worker.Request{
+	chOut, err := w.Send(context.Background(), &worker.Request{
 		Devices: []string{"token1", "token2"},
 		Payload: &fcm.Message{Notification: &fcm.Notification{Title: "title"}},
 	})
+	require.NoError(t, err)
 
 	require.Equal(t,
-		&worker.Response{
-			ProjectID:   w.ProjectID(),
-			DeviceToken: "token1",
-		},
-		<-chOut)
-
-	require.Equal(t,
-		&worker.Response{
-			ProjectID:   w.ProjectID(),
-			DeviceToken: "token2",
+		[]*worker.Response{
+			{
+				ProjectID:   w.ProjectID(),
+				DeviceToken: "token1",
+			},
+			{
+				ProjectID:   w.ProjectID(),
+				DeviceToken: "token2",
+			},
 		},
-		<-chOut)
-
-	_, ok := <-chOut
-	require.False(t, ok)
+		readResponses(chOut))
 }
 
 func TestWokerSendOk(t *testing.T) {
 
 	cfg := getConfig(t)
 	logger := getLogger(t)
-	token := getDeviceToken(t)
+	token := "token1"
 
 	cfg.NopMode = false
 
+	fcmServer.Reset()
+	fcmServer.SetReply("token2", test.ReplyBadDeviceToken)
+
 	w, err := New(cfg, logger, metric.New())
 	require.NoError(t, err)
 
-	chOut := w.Send(context.Background(), &worker.Request{
+	chOut, err := w.Send(context.Background(), &worker.Request{
 		Devices: []string{token, "token2", token},
 		Payload: &fcm.Message{Notification: &fcm.Notification{Title: "title"}},
 	})
+	require.NoError(t, err)
 
-	require.Equal(t,
-		&worker.Response{
-			ProjectID:   w.ProjectID(),
-			DeviceToken: token,
-		},
-		<-chOut)
+	responses := readResponses(chOut)
+	require.Len(t, responses, 3)
+
+	for _, res := range responses[:2] {
+		require.NotEmpty(t, res.MessageID)
+		require.Equal(t,
+			&worker.Response{
+				ProjectID:   w.ProjectID(),
+				DeviceToken: token,
+				MessageID:   res.MessageID,
+			},
+			res)
+	}
 
 	fcmError := &fcm.SendError{
 		Code:    400,
 		Message: `The registration token is not a valid FCM registration token`,
 		Status:  "INVALID_ARGUMENT",
-		Details: json.RawMessage([]byte(`[
-      {
-        "@type": "type.googleapis.com/google.firebase.fcm.v1.FcmError",
-        "errorCode": "INVALID_ARGUMENT"
-      },
-      {
-        "@type": "type.googleapis.com/google.rpc.BadRequest",
-        "fieldViolations": [
-          {
-            "field": "message.token",
-            "description": "The registration token is not a valid FCM registration token"
-          }
-        ]
-      }
-    ]`))}
+		Details: json.RawMessage([]byte(`[{"@type":"type.googleapis.com/google.firebase.fcm.v1.FcmError","errorCode":"INVALID_ARGUMENT"},{"@type":"type.googleapis.com/google.rpc.BadRequest","fieldViolations":[{"description":"The registration token is not a valid FCM registration token","field":"message.token"}]}]`))}
 
-	res := <-chOut
+	res := responses[2]
 	require.Equal(t, fcmError, res.Error.(*worker.ResponseError).Err())
 	require.Equal(t, worker.NewResponseErrorBadDeviceToken(fcmError), res.Error)
 	require.Equal(t,
@@ -133,14 +164,86 @@ func TestWokerSendOk(t *testing.T) {
 		},
 		res)
 
-	require.Equal(t,
-		&worker.Response{
-			ProjectID:   w.ProjectID(),
-			DeviceToken: token,
-		},
-		<-chOut)
+	requests := fcmServer.Requests()
+	tokens := make([]string, 0, len(requests))
+	for _, req := range requests {
+		body := struct {
+			Message struct {
+				Token string `json:"token"`
+			} `json:"message"`
+		}{}
+		require.NoError(t, json.Unmarshal(req.Body, &body))
+		tokens = append(tokens, body.Message.Token)
+
+		require.Equal(t, "/v1/projects/fcm-project/messages:send", req.Path)
+		require.JSONEq(t,
+			`{"message":{"token":"`+body.Message.Token+`","notification":{"title":"title"}}}`,
+			string(req.Body))
+	}
 
-	_, ok := <-chOut
+	require.ElementsMatch(t, []string{token, "token2", token}, tokens)
+}
+
+func TestWokerSendUnregistered(t *testing.T) {
+
+	cfg := getConfig(t)
+	cfg.NopMode = false
+
+	fcmServer.Reset()
+	fcmServer.SetReply("token1", test.ReplyUnregistered)
+
+	w, err := New(cfg, getLogger(t), metric.New())
+	require.NoError(t, err)
+
+	chOut, err := w.Send(context.Background(), &worker.Request{
+		Devices: []string{"token1"},
+		Payload: &fcm.Message{Notification: &fcm.Notification{Title: "title"}},
+	})
+	require.NoError(t, err)
+
+	res := <-chOut
+	resErr, ok := res.Error.(*worker.ResponseError)
+	require.True(t, ok)
+	require.Equal(t, worker.ErrorCodeUnregistered, resErr.Code)
+	require.True(t, resErr.Timestamp.IsZero())
+
+	sendErr, ok := resErr.Err().(*fcm.SendError)
+	require.True(t, ok)
+	require.Equal(t, 404, sendErr.Code)
+	require.Equal(t, fcm.ErrorCodeUnregistered, sendErr.FcmErrorCode())
+
+	_, ok = <-chOut
+	require.False(t, ok)
+}
+
+func TestWokerSendNotFound(t *testing.T) {
+
+	cfg := getConfig(t)
+	cfg.NopMode = false
+	// unknown path of the fake server: 404 without FCM error code
+	cfg.Endpoint = fcmServer.URL + "/v1/projects/unknown"
+
+	fcmServer.Reset()
+
+	w, err := New(cfg, getLogger(t), metric.New())
+	require.NoError(t, err)
+
+	chOut, err := w.Send(context.Background(), &worker.Request{
+		Devices: []string{"token1"},
+		Payload: &fcm.Message{Notification: &fcm.Notification{Title: "title"}},
+	})
+	require.NoError(t, err)
+
+	res := <-chOut
+	_, ok := res.Error.(*worker.ResponseError)
+	require.False(t, ok)
+
+	sendErr, ok := res.Error.(*fcm.SendError)
+	require.True(t, ok)
+	require.Equal(t, 404, sendErr.Code)
+	require.Equal(t, fcm.ErrorCode("NOT_FOUND"), sendErr.FcmErrorCode())
+
+	_, ok = <-chOut
 	require.False(t, ok)
 }
 
@@ -207,7 +310,9 @@ func getConfig(t *testing.T) *Config {
 	src := viper.New()
 	for k, v := range map[string]interface{}{
 		"project-id":      "project-id-123",
-		"service-account": getPathToServiceAccount(t),
+		"service-account": serviceAccountFile,
+		"endpoint":        fcmServer.URL,
+		"ca-file":         caFile,
 		"nop-mode":        "true",
 		"workers":         "-1",
 	} {
@@ -220,20 +325,18 @@ func getConfig(t *testing.T) *Config {
 	return c
 }
 
-func getPathToServiceAccount(t *testing.T) string {
-	t.Helper()
-
-	key, err := test.GetPathToGoogleServiceAccount()
-	require.NoError(t, err)
-
-	return key
-}
+// readResponses reads all responses sorted by device token:
+// the worker sends to the devices concurrently
+func readResponses(chOut <-chan *worker.Response) []*worker.Response {
 
-func getDeviceToken(t *testing.T) string {
-	t.Helper()
+	retval := make([]*worker.Response, 0)
+	for res := range chOut {
+		retval = append(retval, res)
+	}
 
-	token, _, err := test.GetPushDevices()
-	require.NoError(t, err)
+	sort.SliceStable(retval, func(i, j int) bool {
+		return retval[i].DeviceToken < retval[j].DeviceToken
+	})
 
-	return token
+	return retval
 }
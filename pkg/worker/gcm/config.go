@@ -1,6 +1,7 @@
 package gcm
 
 import (
+	"os"
 	"strings"
 	"time"
 
@@ -17,6 +18,11 @@ type Config struct {
 	ServerKey string        `mapstructure:"key"`
 	Retries   int           `mapstructure:"retries"`
 	Timeout   time.Duration `mapstructure:"timeout"`
+
+	// Base URL of the provider API. By default the public provider endpoint is used
+	Endpoint string `mapstructure:"endpoint"`
+	// Path to CA bundle in pem format. By default the host's root CA set is used
+	CAFile string `mapstructure:"ca-file"`
 }
 
 func NewConfig(src *viper.Viper) (*Config, error) {
@@ -36,5 +42,11 @@ func NewConfig(src *viper.Viper) (*Config, error) {
 		return nil, errors.New("invalid server key")
 	}
 
+	if c.CAFile != "" {
+		if _, err := os.Stat(c.CAFile); err != nil {
+			return nil, errors.Wrap(err, "gcm: ca-file")
+		}
+	}
+
 	return c, nil
 }
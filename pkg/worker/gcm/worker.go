@@ -3,7 +3,9 @@ package gcm
 import (
 	"context"
 	"errors"
+	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/dialogs/dialog-push-service/pkg/metric"
 	"github.com/dialogs/dialog-push-service/pkg/provider"
@@ -21,7 +23,12 @@ type Worker struct {
 
 func New(cfg *Config, logger *zap.Logger, svcMetric *metric.Service) (*Worker, error) {
 
-	provider, err := gcm.New([]byte(cfg.ServerKey), cfg.Sandbox, cfg.Retries, cfg.Timeout)
+	rootCAs, err := worker.NewCertPool(cfg.CAFile)
+	if err != nil {
+		return nil, err
+	}
+
+	provider, err := gcm.New([]byte(cfg.ServerKey), cfg.Sandbox, cfg.RetryPolicy(cfg.Retries), cfg.Timeout, cfg.Endpoint, rootCAs)
 	if err != nil {
 		return nil, err
 	}
@@ -49,16 +56,26 @@ func (w *Worker) SupportsVoIP() bool {
 	return false
 }
 
-func (w *Worker) sendNotification(ctx context.Context, in provider.IRequest) error {
+// Close closes the connections of the provider
+func (w *Worker) Close() {
+	w.provider.Close()
+}
+
+// NewRequest returns an empty notification of the provider
+func (w *Worker) NewRequest() provider.IRequest {
+	return &gcm.Request{}
+}
+
+func (w *Worker) sendNotification(ctx context.Context, in provider.IRequest) (*worker.Result, error) {
 
 	req, ok := in.(*gcm.Request)
 	if !ok {
-		return ErrInvalidRequestType
+		return nil, ErrInvalidRequestType
 	}
 
 	answer, err := w.provider.Send(ctx, req)
 	if err != nil {
-		return err
+		return nil, err
 
 	} else if answer.Success == 0 {
 		var answerError error
@@ -69,21 +86,53 @@ func (w *Worker) sendNotification(ctx context.Context, in provider.IRequest) err
 				break
 			}
 
-			if errCode == gcm.ErrorCodeInvalidRegistration ||
-				errCode == gcm.ErrorCodeMissingRegistration {
+			switch errCode {
+			case gcm.ErrorCodeInvalidRegistration, gcm.ErrorCodeMissingRegistration:
+				return nil, worker.NewResponseErrorBadDeviceToken(errors.New(errCode))
 
-				return worker.NewResponseErrorBadDeviceToken(errors.New(errCode))
+			case gcm.ErrorCodeNotRegistered:
+				return nil, worker.NewResponseErrorUnregistered(errors.New(errCode), time.Time{})
+
+			case gcm.ErrorCodeMessageTooBig, gcm.ErrorCodeInvalidDataKey, gcm.ErrorCodeInvalidTTL,
+				gcm.ErrorCodeInvalidPackageName, gcm.ErrorCodeMismatchSenderID:
+				return nil, worker.NewResponseError(worker.ErrorCodeBadRequest, errors.New(errCode))
+
+			case gcm.ErrorCodeDeviceMessageRateExceeded, gcm.ErrorCodeTopicsMessageRateExceeded:
+				return nil, worker.NewResponseError(worker.ErrorCodeTooManyRequests, errors.New(errCode))
+
+			case gcm.ErrorCodeUnavailable, gcm.ErrorCodeInternalServerError:
+				return nil, worker.NewResponseErrorFromAnswer(http.StatusServiceUnavailable, errors.New(errCode))
 			}
 
 			answerError = errors.New(strconv.Itoa(answer.StatusCode) + " " + errCode)
 
+		} else if answer.StatusCode == http.StatusTooManyRequests {
+			answerError = worker.NewResponseErrorThrottled(
+				errors.New(strconv.Itoa(answer.StatusCode) + " " + http.StatusText(answer.StatusCode)))
+
+		} else if answer.StatusCode != 200 {
+			answerError = worker.NewResponseErrorFromAnswer(
+				answer.StatusCode,
+				errors.New(strconv.Itoa(answer.StatusCode)+" "+http.StatusText(answer.StatusCode)))
+
 		} else {
 			answerError = worker.ErrUnknownResponseError
 		}
 
-		return answerError
+		return nil, answerError
+
+	}
 
+	result := &worker.Result{}
+	for _, res := range answer.Results {
+		result.MessageID = res.MessageID
+		// the canonical registration ID of the device:
+		// https://firebase.google.com/docs/cloud-messaging/http-server-ref#interpret-downstream
+		if res.RegistrationID != "" && res.RegistrationID != req.To {
+			result.CanonicalToken = res.RegistrationID
+		}
+		break
 	}
 
-	return nil
+	return result, nil
 }
@@ -2,7 +2,11 @@ package gcm
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"log"
+	"os"
+	"sort"
 	"testing"
 
 	"github.com/dialogs/dialog-push-service/pkg/metric"
@@ -17,6 +21,30 @@ import (
 
 var notification = []byte(`{"title":"title","body":"body text"}`)
 
+var (
+	gcmServer *test.GCMServer
+	caFile    string
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(runTests(m))
+}
+
+func runTests(m *testing.M) int {
+
+	gcmServer = test.NewGCMServer("server-key")
+	defer gcmServer.Close()
+
+	var err error
+	caFile, err = test.SaveTempFile(test.CAPem(gcmServer.Server), "gcm")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer os.Remove(caFile)
+
+	return m.Run()
+}
+
 func TestWokerNew(t *testing.T) {
 
 	cfg := getConfig(t)
@@ -38,7 +66,8 @@ func TestWokerSendErrInvalidDeviceToken(t *testing.T) {
 	w, err := New(cfg, logger, metric.New())
 	require.NoError(t, err)
 
-	chOut := w.Send(context.Background(), &worker.Request{})
+	chOut, err := w.Send(context.Background(), &worker.Request{})
+	require.NoError(t, err)
 	require.Equal(t,
 		&worker.Response{
 			ProjectID: w.ProjectID(),
@@ -58,55 +87,63 @@ func TestWokerSendNopOk(t *testing.T) {
 	w, err := New(cfg, logger, metric.New())
 	require.NoError(t, err)
 
-	chOut := w.Send(context.Background(), &worker.Request{
+	chOut, err := w.Send(context.Background(), &worker.Request{
 		Devices: []string{"token1", "token2"},
 		Payload: &gcm.Request{},
 	})
+	require.NoError(t, err)
 
 	require.Equal(t,
-		&worker.Response{
-			ProjectID:   w.ProjectID(),
-			DeviceToken: "token1",
-		},
-		<-chOut)
-
-	require.Equal(t,
-		&worker.Response{
-			ProjectID:   w.ProjectID(),
-			DeviceToken: "token2",
+		[]*worker.Response{
+			{
+				ProjectID:   w.ProjectID(),
+				DeviceToken: "token1",
+			},
+			{
+				ProjectID:   w.ProjectID(),
+				DeviceToken: "token2",
+			},
 		},
-		<-chOut)
-
-	_, ok := <-chOut
-	require.False(t, ok)
+		readResponses(chOut))
 }
 
 func TestWokerSendOk(t *testing.T) {
 
 	cfg := getConfig(t)
 	logger := getLogger(t)
-	token := getDeviceToken(t)
+	token := "token1"
 
 	cfg.NopMode = false
 
+	gcmServer.Reset()
+	gcmServer.SetReply("token2", test.ReplyBadDeviceToken)
+
 	w, err := New(cfg, logger, metric.New())
 	require.NoError(t, err)
 
-	chOut := w.Send(context.Background(), &worker.Request{
+	chOut, err := w.Send(context.Background(), &worker.Request{
 		Devices: []string{token, "token2", token},
 		Payload: &gcm.Request{Notification: notification},
 	})
+	require.NoError(t, err)
 
-	require.Equal(t,
-		&worker.Response{
-			ProjectID:   w.ProjectID(),
-			DeviceToken: token,
-		},
-		<-chOut)
+	responses := readResponses(chOut)
+	require.Len(t, responses, 3)
+
+	for _, res := range responses[:2] {
+		require.NotEmpty(t, res.MessageID)
+		require.Equal(t,
+			&worker.Response{
+				ProjectID:   w.ProjectID(),
+				DeviceToken: token,
+				MessageID:   res.MessageID,
+			},
+			res)
+	}
 
 	fcmError := errors.New(gcm.ErrorCodeInvalidRegistration)
 
-	res := <-chOut
+	res := responses[2]
 	require.Equal(t, fcmError, res.Error.(*worker.ResponseError).Err())
 	require.Equal(t, worker.NewResponseErrorBadDeviceToken(fcmError), res.Error)
 	require.Equal(t,
@@ -117,15 +154,51 @@ func TestWokerSendOk(t *testing.T) {
 		},
 		res)
 
-	require.Equal(t,
-		&worker.Response{
-			ProjectID:   w.ProjectID(),
-			DeviceToken: token,
-		},
-		<-chOut)
+	requests := gcmServer.Requests()
+	tokens := make([]string, 0, len(requests))
+	for _, req := range requests {
+		body := struct {
+			To string `json:"to"`
+		}{}
+		require.NoError(t, json.Unmarshal(req.Body, &body))
+		tokens = append(tokens, body.To)
+
+		require.Equal(t, "key=server-key", req.Header.Get("Authorization"))
+		require.JSONEq(t,
+			`{"to":"`+body.To+`","notification":`+string(notification)+`}`,
+			string(req.Body))
+	}
 
-	_, ok := <-chOut
-	require.False(t, ok)
+	require.ElementsMatch(t, []string{token, "token2", token}, tokens)
+}
+
+func TestWokerSendCanonicalID(t *testing.T) {
+
+	cfg := getConfig(t)
+	cfg.NopMode = false
+
+	gcmServer.Reset()
+	gcmServer.SetCanonicalID("token1", "token2")
+
+	w, err := New(cfg, getLogger(t), metric.New())
+	require.NoError(t, err)
+
+	chOut, err := w.Send(context.Background(), &worker.Request{
+		Devices: []string{"token1", "token2"},
+		Payload: &gcm.Request{Notification: notification},
+	})
+	require.NoError(t, err)
+
+	responses := readResponses(chOut)
+	require.Len(t, responses, 2)
+
+	require.Equal(t, "token1", responses[0].DeviceToken)
+	require.NoError(t, responses[0].Error)
+	require.Equal(t, "token2", responses[0].CanonicalToken)
+
+	require.Equal(t, "token2", responses[1].DeviceToken)
+	require.NoError(t, responses[1].Error)
+	require.Empty(t, responses[1].CanonicalToken)
 }
 
 func getLogger(t *testing.T) *zap.Logger {
@@ -145,7 +218,9 @@ func getConfig(t *testing.T) *Config {
 	src := viper.New()
 	for k, v := range map[string]interface{}{
 		"project-id": "project-id-123",
-		"key":        getGcmKey(t),
+		"key":        "server-key",
+		"endpoint":   gcmServer.URL,
+		"ca-file":    caFile,
 		"nop-mode":   "true",
 		"workers":    "-1",
 	} {
@@ -158,20 +233,18 @@ func getConfig(t *testing.T) *Config {
 	return c
 }
 
-func getGcmKey(t *testing.T) []byte {
-	t.Helper()
-
-	key, err := test.GetAccountKey()
-	require.NoError(t, err)
-
-	return key
-}
+// readResponses reads all responses sorted by device token:
+// the worker sends to the devices concurrently
+func readResponses(chOut <-chan *worker.Response) []*worker.Response {
 
-func getDeviceToken(t *testing.T) string {
-	t.Helper()
+	retval := make([]*worker.Response, 0)
+	for res := range chOut {
+		retval = append(retval, res)
+	}
 
-	token, _, err := test.GetPushDevices()
-	require.NoError(t, err)
+	sort.SliceStable(retval, func(i, j int) bool {
+		return retval[i].DeviceToken < retval[j].DeviceToken
+	})
 
-	return token
+	return retval
 }
@@ -2,6 +2,8 @@ package worker
 
 import (
 	"bytes"
+	"crypto/x509"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -34,3 +36,24 @@ func ReadFile(path string, maxSize int64) ([]byte, error) {
 
 	return buf.Bytes(), nil
 }
+
+// NewCertPool returns a set of certificate authorities from the bundle in pem format.
+// If the path is empty, the method returns nil: the host's root CA set is used
+func NewCertPool(path string) (*x509.CertPool, error) {
+
+	if path == "" {
+		return nil, nil
+	}
+
+	data, err := ReadFile(path, 1024*1024*10)
+	if err != nil {
+		return nil, err
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, errors.New("invalid CA bundle: " + path)
+	}
+
+	return pool, nil
+}
@@ -4,12 +4,25 @@ import (
 	"context"
 
 	"github.com/dialogs/dialog-push-service/pkg/conversion"
+	"github.com/dialogs/dialog-push-service/pkg/provider"
 )
 
 type IWorker interface {
 	Kind() Kind
 	ProjectID() string
-	Send(context.Context, *Request) <-chan *Response
+	Send(context.Context, *Request) (<-chan *Response, error)
+	Admit(context.Context, *Request) (Admission, error)
 	ConversionConfig() *conversion.Config
 	SupportsVoIP() bool
+	NewRequest() provider.IRequest
+	Health() error
+	InFlight() int
+	Close()
+}
+
+// Admission is the place of the request in the worker queue. The place is
+// taken until the request is sent by Send (once) or the admission is released
+type Admission interface {
+	Send(context.Context) <-chan *Response
+	Release()
 }
@@ -0,0 +1,37 @@
+package worker
+
+import (
+	"fmt"
+
+	"github.com/dialogs/dialog-go-lib/enum"
+)
+
+// Priority of a request: every priority has a separate queue and
+// reserved threads of the worker
+const (
+	PriorityLow    Priority = 0
+	PriorityNormal Priority = 1
+	PriorityHigh   Priority = 2
+
+	countPriorities = 3
+)
+
+type Priority int
+
+var _PriorityEnum = enum.New("worker priority").
+	Add(PriorityLow, "low").       // silent and read pushes
+	Add(PriorityNormal, "normal"). // alerting and encrypted pushes
+	Add(PriorityHigh, "high")      // voip pushes
+
+func (p Priority) String() string {
+	val, ok := _PriorityEnum.GetByIndex(p)
+	if !ok {
+		return fmt.Sprintf("invalid worker priority: %d", p)
+	}
+
+	return val
+}
+
+func (p Priority) valid() bool {
+	return p >= PriorityLow && p < countPriorities
+}
@@ -6,4 +6,5 @@ type Request struct {
 	Devices       []string
 	CorrelationID string
 	Payload       provider.IRequest
+	Priority      Priority
 }
@@ -1,14 +1,24 @@
 package worker
 
 import (
+	"context"
+	"net"
+	"net/http"
+	"net/url"
 	"strconv"
+	"time"
+
+	"github.com/dialogs/dialog-push-service/pkg/provider"
+	"github.com/pkg/errors"
 )
 
 const (
-	ErrorCodeUnknown        ErrorCode = 0
-	ErrorCodeUnregistered   ErrorCode = 1
-	ErrorCodeBadDeviceToken ErrorCode = 2
-	ErrorCodeBadRequest     ErrorCode = 3
+	ErrorCodeUnknown         ErrorCode = 0
+	ErrorCodeUnregistered    ErrorCode = 1
+	ErrorCodeBadDeviceToken  ErrorCode = 2
+	ErrorCodeBadRequest      ErrorCode = 3
+	ErrorCodeTooManyRequests ErrorCode = 4
+	ErrorCodeCircuitOpen     ErrorCode = 5
 )
 
 type ErrorCode int
@@ -16,12 +26,27 @@ type ErrorCode int
 type Response struct {
 	ProjectID   string
 	DeviceToken string
-	Error       error
+	// provider message ID: apns-id (APNs), name (FCM), message_id (legacy FCM)
+	MessageID string
+	// CanonicalToken is a new token of the device if the token was changed (legacy FCM only)
+	CanonicalToken string
+	Error          error
+}
+
+// Result is an answer of a provider on the notification
+type Result struct {
+	MessageID      string
+	CanonicalToken string
 }
 
 type ResponseError struct {
 	Code ErrorCode
-	err  error
+	// Timestamp is the last time when the device token was valid (APNs only)
+	Timestamp time.Time
+	// Throttled is true if the provider limits the requests of the whole project,
+	// not the requests to the device (ErrorCodeTooManyRequests only)
+	Throttled bool
+	err       error
 }
 
 func NewResponseError(code ErrorCode, err error) *ResponseError {
@@ -31,17 +56,48 @@ func NewResponseError(code ErrorCode, err error) *ResponseError {
 	}
 }
 
+// NewResponseErrorFromAnswer returns an error by http status code of the provider answer.
+// The unknown status code is used as the error code
 func NewResponseErrorFromAnswer(code int, err error) *ResponseError {
+
+	errCode := ErrorCode(code)
+	switch code {
+	case http.StatusBadRequest, http.StatusRequestEntityTooLarge:
+		errCode = ErrorCodeBadRequest
+	case http.StatusTooManyRequests:
+		errCode = ErrorCodeTooManyRequests
+	}
+
 	return &ResponseError{
-		Code: ErrorCode(code),
+		Code: errCode,
 		err:  err,
 	}
 }
 
+// NewResponseErrorThrottled returns an error of the provider quota of the project:
+// the rate limit of the worker is reduced by the error
+func NewResponseErrorThrottled(err error) *ResponseError {
+	return &ResponseError{
+		Code:      ErrorCodeTooManyRequests,
+		Throttled: true,
+		err:       err,
+	}
+}
+
 func NewResponseErrorBadDeviceToken(err error) *ResponseError {
 	return NewResponseError(ErrorCodeBadDeviceToken, err)
 }
 
+// NewResponseErrorUnregistered returns an error of the device token that is
+// no longer active. The timestamp is zero if the provider doesn't report it
+func NewResponseErrorUnregistered(err error, timestamp time.Time) *ResponseError {
+	return &ResponseError{
+		Code:      ErrorCodeUnregistered,
+		Timestamp: timestamp,
+		err:       err,
+	}
+}
+
 func (r *ResponseError) Error() string {
 	return strconv.Itoa(int(r.Code)) + " " + r.err.Error()
 }
@@ -49,3 +105,54 @@ func (r *ResponseError) Error() string {
 func (r *ResponseError) Err() error {
 	return r.err
 }
+
+// IsRetryable returns true if the notification can be sent later:
+// the provider is unavailable or the request rate is exceeded.
+// The notification canceled by the context isn't retryable
+func IsRetryable(err error) bool {
+
+	if err == nil || isContextError(err) {
+		return false
+	}
+
+	if e, ok := errors.Cause(err).(*ResponseError); ok && (e.Code == ErrorCodeTooManyRequests || e.Code == ErrorCodeCircuitOpen) {
+		return true
+	}
+
+	return isProviderFailure(err)
+}
+
+// isProviderFailure returns true if the provider is unavailable: transport errors,
+// timeouts and server errors (5xx)
+func isProviderFailure(err error) bool {
+
+	if err == nil {
+		return false
+	}
+
+	switch e := errors.Cause(err).(type) {
+	case *ResponseError:
+		return e.Code >= http.StatusInternalServerError
+	case net.Error:
+		return true
+	}
+
+	switch errors.Cause(err) {
+	case provider.ErrInternalServerError, provider.ErrServiceUnavailable, context.DeadlineExceeded:
+		return true
+	}
+
+	return false
+}
+
+// isContextError returns true if the request is canceled or the deadline of the request is exceeded:
+// the context error is wrapped by the provider clients (errors.Wrap) or by the HTTP client (*url.Error)
+func isContextError(err error) bool {
+
+	cause := errors.Cause(err)
+	if e, ok := cause.(*url.Error); ok {
+		cause = errors.Cause(e.Err)
+	}
+
+	return cause == context.Canceled || cause == context.DeadlineExceeded
+}
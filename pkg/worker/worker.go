@@ -3,10 +3,13 @@ package worker
 import (
 	"context"
 	"runtime"
+	"sync"
+	"time"
 
 	"github.com/dialogs/dialog-push-service/pkg/conversion"
 	"github.com/dialogs/dialog-push-service/pkg/metric"
 	"github.com/dialogs/dialog-push-service/pkg/provider"
+	"github.com/dialogs/dialog-push-service/pkg/ratelimit"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
 )
@@ -15,15 +18,30 @@ var (
 	ErrEmptyToken           = NewResponseError(ErrorCodeBadDeviceToken, errors.New("empty device token"))
 	ErrUnknownResponseError = NewResponseError(ErrorCodeUnknown, errors.New("unknown response error"))
 	ErrInvalidOutDataType   = NewResponseError(ErrorCodeBadRequest, errors.New("invalid out data type"))
+	ErrQueueFull            = NewResponseError(ErrorCodeTooManyRequests, errors.New("worker queue is full"))
 )
 
-type FnSendNotification func(ctx context.Context, out provider.IRequest) error
+const (
+	DefaultQueueSize           = 100
+	DefaultQueueTimeout        = time.Second
+	DefaultHighPriorityThreads = 1
+)
+
+// FnSendNotification sends the notification to a device. The result can be
+// returned with the error: some providers return message ID for failed notifications
+type FnSendNotification func(ctx context.Context, out provider.IRequest) (*Result, error)
 
 type Worker struct {
-	projectID          string
-	kind               Kind
-	nopMode            bool
-	threads            chan struct{}
+	projectID    string
+	kind         Kind
+	nopMode      bool
+	threads      chan struct{}
+	lanes        [countPriorities]*lane
+	queueTimeout time.Duration
+	// limiter and rateLimitMetric are nil if the rate limit is disabled
+	limiter            *ratelimit.Limiter
+	rateLimitMetric    *metric.RateLimit
+	breaker            *breaker
 	logger             *zap.Logger
 	metric             *metric.Provider
 	conversionConfig   conversion.Config
@@ -44,9 +62,25 @@ func New(
 		countThreads = runtime.NumCPU()
 	}
 
-	threads := make(chan struct{}, countThreads)
-	for i := 0; i < countThreads; i++ {
-		threads <- struct{}{}
+	queueSize := cfg.QueueSize
+	if queueSize <= 0 {
+		queueSize = DefaultQueueSize
+	}
+
+	highPriorityThreads := cfg.HighPriorityThreads
+	if highPriorityThreads <= 0 {
+		highPriorityThreads = DefaultHighPriorityThreads
+	}
+
+	lanes := [countPriorities]*lane{
+		PriorityLow:    newLane(queueSize, 0),
+		PriorityNormal: newLane(queueSize, cfg.NormalPriorityThreads),
+		PriorityHigh:   newLane(queueSize, highPriorityThreads),
+	}
+
+	queueTimeout := cfg.QueueTimeout
+	if queueTimeout <= 0 {
+		queueTimeout = DefaultQueueTimeout
 	}
 
 	providerMetric, err := svcMetric.GetProviderMetrics(kind.String(), cfg.ProjectID)
@@ -54,6 +88,20 @@ func New(
 		return nil, err
 	}
 
+	var (
+		limiter         *ratelimit.Limiter
+		rateLimitMetric *metric.RateLimit
+	)
+	if cfg.RateLimit > 0 {
+		rateLimitMetric, err = svcMetric.GetRateLimitMetric(kind.String(), cfg.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+
+		limiter = ratelimit.New(cfg.RateLimit, cfg.RateBurst)
+		rateLimitMetric.Set(limiter.Rate())
+	}
+
 	l := logger.With(
 		zap.String("worker", kind.String()),
 		zap.String("project ID", cfg.ProjectID))
@@ -61,11 +109,32 @@ func New(
 		l = l.With(zap.Bool("develop", sandbox))
 	}
 
+	breakerFailures := cfg.BreakerFailures
+	if breakerFailures <= 0 {
+		breakerFailures = DefaultBreakerFailures
+	}
+
+	breakerTimeout := cfg.BreakerTimeout
+	if breakerTimeout <= 0 {
+		breakerTimeout = DefaultBreakerTimeout
+	}
+
+	providerMetric.SetBreakerState(int(BreakerClosed))
+	providerBreaker := newBreaker(breakerFailures, breakerTimeout, func(from, to BreakerState) {
+		providerMetric.SetBreakerState(int(to))
+		l.Warn("circuit breaker", zap.Stringer("from", from), zap.Stringer("to", to))
+	})
+
 	return &Worker{
 		projectID:          cfg.ProjectID,
 		kind:               kind,
 		nopMode:            cfg.NopMode,
-		threads:            threads,
+		threads:            newThreads(countThreads),
+		lanes:              lanes,
+		queueTimeout:       queueTimeout,
+		limiter:            limiter,
+		rateLimitMetric:    rateLimitMetric,
+		breaker:            providerBreaker,
 		logger:             l,
 		conversionConfig:   *cfg.Config,
 		metric:             providerMetric,
@@ -89,75 +158,282 @@ func (w *Worker) ConversionConfig() *conversion.Config {
 	return &w.conversionConfig
 }
 
-func (w *Worker) Send(ctx context.Context, req *Request) <-chan *Response {
+// Health returns nil if the provider is available: the circuit breaker isn't open
+func (w *Worker) Health() error {
+
+	if w.breaker.currentState() == BreakerOpen {
+		return ErrCircuitOpen
+	}
+
+	return nil
+}
+
+// InFlight returns the count of the requests in the queues of the worker
+func (w *Worker) InFlight() int {
 
+	count := 0
+	for _, l := range w.lanes {
+		count += len(l.queue)
+	}
+
+	return count
+}
+
+// Send sends the notification to the devices concurrently: every device
+// takes a thread of the worker. The responses are written to the channel
+// in order of completion. The request is rejected with ErrQueueFull
+// if the worker queue of the request priority is full
+func (w *Worker) Send(ctx context.Context, req *Request) (<-chan *Response, error) {
+
+	a, err := w.Admit(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.Send(ctx), nil
+}
+
+// Admit takes a place of the request in the worker queue without sending.
+// The request is rejected with ErrQueueFull if the queue of the request priority is full
+func (w *Worker) Admit(ctx context.Context, req *Request) (Admission, error) {
+
+	if !req.Priority.valid() {
+		return nil, NewResponseError(ErrorCodeBadRequest, errors.New(req.Priority.String()))
+	}
+
+	l := w.lanes[req.Priority]
+	if err := w.admit(ctx, l); err != nil {
+		return nil, err
+	}
+
+	return &admission{
+		worker: w,
+		lane:   l,
+		req:    req,
+	}, nil
+}
+
+// admission is the request admitted to the queue of the lane
+type admission struct {
+	worker  *Worker
+	lane    *lane
+	req     *Request
+	release sync.Once
+}
+
+func (a *admission) Release() {
+	a.release.Do(func() { <-a.lane.queue })
+}
+
+func (a *admission) Send(ctx context.Context) <-chan *Response {
+
+	w, l, req := a.worker, a.lane, a.req
 	ch := make(chan *Response)
-	reserved := <-w.threads
-	// TODO: add wait timeout. if timeout is end, write to storage for retry
 
 	go func() {
-		defer func() { w.threads <- reserved }()
+		wg := sync.WaitGroup{}
+		defer a.Release()
 		defer close(ch)
+		defer wg.Wait()
 
 		if len(req.Devices) == 0 {
 			w.logger.Error(ErrEmptyToken.Error())
 
-			ch <- &Response{
-				ProjectID: w.projectID,
-				Error:     ErrEmptyToken,
+			select {
+			case ch <- &Response{ProjectID: w.projectID, Error: ErrEmptyToken}:
+			case <-ctx.Done():
 			}
 			return
 		}
 
 		for _, token := range req.Devices {
-			resp := &Response{
-				ProjectID:   w.projectID,
-				DeviceToken: token,
+			release, ok := w.acquireThread(ctx, l)
+			if !ok {
+				return
 			}
 
-			// hide device token
-			tokenInfo := ""
-			tokenPartLen := len(token) / 3
-			if tokenPartLen > 0 {
-				tokenInfo = token[:tokenPartLen] + "..." + token[len(token)-tokenPartLen:]
-			}
+			wg.Add(1)
+			go func(token string) {
+				defer wg.Done()
 
-			l := w.logger.With(
-				zap.String("token", tokenInfo),
-				zap.String("id", req.CorrelationID))
+				resp := w.send(ctx, req, token)
+				release()
 
-			select {
-			case <-ctx.Done():
-				return
-			default:
-				if resp.DeviceToken == "" {
-					l.Error("empty token")
-					resp.Error = ErrEmptyToken
-
-				} else if w.nopMode {
-					l.Info("nop mode", zap.Any("send notification", resp))
-
-				} else {
-					req.Payload.SetToken(token)
-
-					timerCancel := w.metric.NewIOTimer()
-					err := w.fnSendNotification(ctx, req.Payload)
-					timerCancel()
-
-					if err != nil {
-						w.metric.FailsInc()
-						resp.Error = err
-						l.Error("failed to send", zap.Error(resp.Error))
-					} else {
-						w.metric.SuccessInc()
-						l.Info("success send")
-					}
+				select {
+				case ch <- resp:
+				case <-ctx.Done():
 				}
-			}
-
-			ch <- resp
+			}(token)
 		}
 	}()
 
 	return ch
 }
+
+// admit takes a place of the request in the queue of the lane. The request
+// waits for a place until `queue-timeout` ends or the context is done
+func (w *Worker) admit(ctx context.Context, l *lane) error {
+
+	select {
+	case l.queue <- struct{}{}:
+		return nil
+	default:
+	}
+
+	timer := time.NewTimer(w.queueTimeout)
+	defer timer.Stop()
+
+	select {
+	case l.queue <- struct{}{}:
+		return nil
+
+	case <-ctx.Done():
+		return ctx.Err()
+
+	case <-timer.C:
+		w.metric.RejectedInc()
+		w.logger.Warn("reject request", zap.Int("queue size", cap(l.queue)), zap.Error(ErrQueueFull))
+		return ErrQueueFull
+	}
+}
+
+// acquireThread takes a reserved thread of the lane or a shared thread of the worker.
+// The reserved threads are taken first: the shared threads are left for other lanes
+func (w *Worker) acquireThread(ctx context.Context, l *lane) (release func(), ok bool) {
+
+	select {
+	case <-l.reserved:
+		return l.releaseReserved, true
+	default:
+	}
+
+	select {
+	case <-l.reserved:
+		return l.releaseReserved, true
+	case <-w.threads:
+		return w.releaseThread, true
+	case <-ctx.Done():
+		return nil, false
+	}
+}
+
+func (w *Worker) releaseThread() {
+	w.threads <- struct{}{}
+}
+
+// send sends the copy of the notification to the device
+func (w *Worker) send(ctx context.Context, req *Request, token string) *Response {
+
+	resp := &Response{
+		ProjectID:   w.projectID,
+		DeviceToken: token,
+	}
+
+	// hide device token
+	tokenInfo := ""
+	tokenPartLen := len(token) / 3
+	if tokenPartLen > 0 {
+		tokenInfo = token[:tokenPartLen] + "..." + token[len(token)-tokenPartLen:]
+	}
+
+	l := w.logger.With(
+		zap.String("token", tokenInfo),
+		zap.String("id", req.CorrelationID))
+
+	if resp.DeviceToken == "" {
+		l.Error("empty token")
+		resp.Error = ErrEmptyToken
+
+	} else if w.nopMode {
+		l.Info("nop mode", zap.Any("send notification", resp))
+
+	} else {
+		payload := req.Payload.Clone()
+		payload.SetToken(token)
+
+		result, err := w.sendNotification(ctx, payload)
+
+		if result != nil {
+			resp.MessageID = result.MessageID
+			resp.CanonicalToken = result.CanonicalToken
+		}
+
+		if err != nil {
+			w.metric.FailsInc()
+			resp.Error = err
+			l.Error("failed to send", zap.Error(resp.Error))
+		} else {
+			w.metric.SuccessInc()
+			l.Info("success send")
+		}
+	}
+
+	return resp
+}
+
+// sendNotification sends the notification by the circuit breaker and the rate limit.
+// The limit is reduced if the provider throttles the notifications of the project
+func (w *Worker) sendNotification(ctx context.Context, payload provider.IRequest) (*Result, error) {
+
+	if !w.breaker.allow() {
+		return nil, ErrCircuitOpen
+	}
+
+	if w.limiter != nil {
+		if err := w.limiter.Wait(ctx); err != nil {
+			w.breaker.done(ctx, nil)
+			return nil, err
+		}
+	}
+
+	timerCancel := w.metric.NewIOTimer()
+	result, err := w.fnSendNotification(ctx, payload)
+	timerCancel()
+
+	w.breaker.done(ctx, err)
+
+	if w.limiter != nil {
+		if resErr, ok := err.(*ResponseError); ok && resErr.Throttled {
+			w.limiter.Throttled()
+			w.logger.Warn("reduce rate limit", zap.Float64("rate", w.limiter.Rate()))
+		}
+
+		w.rateLimitMetric.Set(w.limiter.Rate())
+	}
+
+	return result, err
+}
+
+// lane is a queue of requests with the same priority
+type lane struct {
+	queue chan struct{}
+	// reserved threads are used by the lane only. Nil if the lane hasn't reserved threads
+	reserved chan struct{}
+}
+
+func newLane(queueSize, reservedThreads int) *lane {
+
+	l := &lane{
+		queue: make(chan struct{}, queueSize),
+	}
+
+	if reservedThreads > 0 {
+		l.reserved = newThreads(reservedThreads)
+	}
+
+	return l
+}
+
+func (l *lane) releaseReserved() {
+	l.reserved <- struct{}{}
+}
+
+func newThreads(count int) chan struct{} {
+
+	threads := make(chan struct{}, count)
+	for i := 0; i < count; i++ {
+		threads <- struct{}{}
+	}
+
+	return threads
+}
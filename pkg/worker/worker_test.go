@@ -0,0 +1,431 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/url"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dialogs/dialog-push-service/pkg/conversion"
+	"github.com/dialogs/dialog-push-service/pkg/metric"
+	"github.com/dialogs/dialog-push-service/pkg/provider"
+	"github.com/dialogs/dialog-push-service/pkg/ratelimit"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type testRequest struct {
+	token string
+}
+
+func (r *testRequest) SetToken(token string) {
+	r.token = token
+}
+
+func (r *testRequest) ShouldIgnore() bool {
+	return false
+}
+
+func (r *testRequest) Clone() provider.IRequest {
+	clone := *r
+	return &clone
+}
+
+func TestWorkerSendConcurrently(t *testing.T) {
+
+	const countThreads = 4
+
+	mu := sync.Mutex{}
+	inflight := 0
+	maxInflight := 0
+
+	w, err := New(
+		&Config{
+			Config:       &conversion.Config{},
+			ProjectID:    "project-id-123",
+			CountThreads: countThreads,
+		},
+		KindGcm,
+		false,
+		zap.NewNop(),
+		metric.New(),
+		func(_ context.Context, out provider.IRequest) (*Result, error) {
+			mu.Lock()
+			inflight++
+			if inflight > maxInflight {
+				maxInflight = inflight
+			}
+			mu.Unlock()
+
+			time.Sleep(20 * time.Millisecond)
+
+			mu.Lock()
+			inflight--
+			mu.Unlock()
+
+			return &Result{MessageID: "id-" + out.(*testRequest).token}, nil
+		})
+	require.NoError(t, err)
+	// the rate limit is disabled: the gauge isn't exported
+	require.Nil(t, w.limiter)
+	require.Nil(t, w.rateLimitMetric)
+
+	devices := make([]string, 0)
+	for i := 0; i < 3*countThreads; i++ {
+		devices = append(devices, "token"+strconv.Itoa(i))
+	}
+
+	payload := &testRequest{}
+	messageIDs := make([]string, 0, len(devices))
+	chOut, err := w.Send(context.Background(), &Request{Devices: devices, Payload: payload})
+	require.NoError(t, err)
+
+	for res := range chOut {
+		require.NoError(t, res.Error)
+		require.Equal(t, "id-"+res.DeviceToken, res.MessageID)
+		messageIDs = append(messageIDs, res.MessageID)
+	}
+
+	require.Len(t, messageIDs, len(devices))
+	require.Greater(t, maxInflight, 1)
+	require.LessOrEqual(t, maxInflight, countThreads)
+	// the payload of the request isn't changed: every device gets a copy
+	require.Empty(t, payload.token)
+}
+
+func TestWorkerSendCanceled(t *testing.T) {
+
+	w, err := New(
+		&Config{
+			Config:       &conversion.Config{},
+			ProjectID:    "project-id-123",
+			CountThreads: 1,
+		},
+		KindGcm,
+		false,
+		zap.NewNop(),
+		metric.New(),
+		func(ctx context.Context, _ provider.IRequest) (*Result, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	chOut, err := w.Send(ctx, &Request{
+		Devices: []string{"token1", "token2", "token3"},
+		Payload: &testRequest{},
+	})
+	require.NoError(t, err)
+
+	cancel()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for range chOut {
+		}
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		require.Fail(t, "the worker isn't stopped")
+	}
+}
+
+func TestWorkerSendQueueFull(t *testing.T) {
+
+	unblock := make(chan struct{})
+
+	w, err := New(
+		&Config{
+			Config:       &conversion.Config{},
+			ProjectID:    "project-id-123",
+			CountThreads: 1,
+			QueueSize:    1,
+			QueueTimeout: 10 * time.Millisecond,
+		},
+		KindGcm,
+		false,
+		zap.NewNop(),
+		metric.New(),
+		func(context.Context, provider.IRequest) (*Result, error) {
+			<-unblock
+			return &Result{}, nil
+		})
+	require.NoError(t, err)
+
+	req := &Request{Devices: []string{"token1"}, Payload: &testRequest{}}
+
+	chOut, err := w.Send(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, 1, w.InFlight())
+
+	// the queue is full: the request waits for `queue-timeout`
+	start := time.Now()
+	_, err = w.Send(context.Background(), req)
+	require.Equal(t, ErrQueueFull, err)
+	require.True(t, IsRetryable(err))
+	require.True(t, time.Since(start) >= 10*time.Millisecond)
+
+	// the request doesn't wait if the context is done
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = w.Send(ctx, req)
+	require.Equal(t, context.Canceled, err)
+
+	close(unblock)
+	for range chOut {
+	}
+
+	// the place of the sent request is released
+	chOut, err = w.Send(context.Background(), req)
+	require.NoError(t, err)
+
+	for res := range chOut {
+		require.NoError(t, res.Error)
+	}
+}
+
+func TestWorkerAdmit(t *testing.T) {
+
+	w, err := New(
+		&Config{
+			Config:       &conversion.Config{},
+			ProjectID:    "project-id-123",
+			CountThreads: 1,
+			QueueSize:    1,
+			QueueTimeout: 10 * time.Millisecond,
+		},
+		KindGcm,
+		false,
+		zap.NewNop(),
+		metric.New(),
+		func(context.Context, provider.IRequest) (*Result, error) {
+			return &Result{}, nil
+		})
+	require.NoError(t, err)
+
+	req := &Request{Devices: []string{"token1"}, Payload: &testRequest{}}
+
+	a, err := w.Admit(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, 1, w.InFlight())
+
+	_, err = w.Admit(context.Background(), req)
+	require.Equal(t, ErrQueueFull, err)
+
+	// the place of the request, which isn't sent, is released once
+	a.Release()
+	a.Release()
+	require.Equal(t, 0, w.InFlight())
+
+	// the response of the request without devices isn't read: the place
+	// is released after the context is done
+	ctx, cancel := context.WithCancel(context.Background())
+	_, err = w.Send(ctx, &Request{Payload: &testRequest{}})
+	require.NoError(t, err)
+	require.Equal(t, 1, w.InFlight())
+
+	cancel()
+
+	for deadline := time.Now().Add(time.Second); w.InFlight() > 0 && time.Now().Before(deadline); {
+		time.Sleep(time.Millisecond)
+	}
+	require.Equal(t, 0, w.InFlight())
+}
+
+func TestWorkerSendPriority(t *testing.T) {
+
+	unblock := make(chan struct{})
+
+	w, err := New(
+		&Config{
+			Config:              &conversion.Config{},
+			ProjectID:           "project-id-123",
+			CountThreads:        1,
+			HighPriorityThreads: 1,
+			QueueSize:           1,
+			QueueTimeout:        10 * time.Millisecond,
+		},
+		KindApns,
+		false,
+		zap.NewNop(),
+		metric.New(),
+		func(_ context.Context, out provider.IRequest) (*Result, error) {
+			if out.(*testRequest).token == "silent" {
+				<-unblock
+			}
+			return &Result{}, nil
+		})
+	require.NoError(t, err)
+
+	// the bulk request takes the shared thread and the place in the low-priority queue
+	chLow, err := w.Send(context.Background(), &Request{
+		Devices:  []string{"silent", "silent"},
+		Payload:  &testRequest{},
+		Priority: PriorityLow,
+	})
+	require.NoError(t, err)
+
+	_, err = w.Send(context.Background(), &Request{
+		Devices:  []string{"silent"},
+		Payload:  &testRequest{},
+		Priority: PriorityLow,
+	})
+	require.Equal(t, ErrQueueFull, err)
+
+	// the call uses the reserved thread
+	chHigh, err := w.Send(context.Background(), &Request{
+		Devices:  []string{"voip1", "voip2"},
+		Payload:  &testRequest{},
+		Priority: PriorityHigh,
+	})
+	require.NoError(t, err)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for res := range chHigh {
+			require.NoError(t, res.Error)
+		}
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		require.Fail(t, "the high-priority request waits for the bulk request")
+	}
+
+	close(unblock)
+	for range chLow {
+	}
+
+	_, err = w.Send(context.Background(), &Request{Priority: countPriorities})
+	require.Equal(t, ErrorCodeBadRequest, err.(*ResponseError).Code)
+	require.EqualError(t, err.(*ResponseError).Err(), "invalid worker priority: 3")
+}
+
+func TestWorkerSendRateLimit(t *testing.T) {
+
+	w, err := New(
+		&Config{
+			Config:       &conversion.Config{},
+			ProjectID:    "project-id-123",
+			CountThreads: 1,
+			RateLimit:    1000,
+			RateBurst:    1,
+		},
+		KindFcm,
+		false,
+		zap.NewNop(),
+		metric.New(),
+		func(_ context.Context, out provider.IRequest) (*Result, error) {
+			switch out.(*testRequest).token {
+			case "device-limited":
+				// APNs: too many requests to the device
+				return nil, NewResponseErrorFromAnswer(429, errors.New("429 TooManyRequests"))
+			case "limited":
+				return nil, NewResponseErrorThrottled(errors.New("QUOTA_EXCEEDED"))
+			}
+			return &Result{}, nil
+		})
+	require.NoError(t, err)
+	require.Equal(t, 1000.0, w.limiter.Rate())
+	require.NotNil(t, w.rateLimitMetric)
+
+	chOut, err := w.Send(context.Background(), &Request{
+		Devices: []string{"token1", "device-limited"},
+		Payload: &testRequest{},
+	})
+	require.NoError(t, err)
+
+	for res := range chOut {
+		if res.DeviceToken == "device-limited" {
+			require.Equal(t, ErrorCodeTooManyRequests, res.Error.(*ResponseError).Code)
+		}
+	}
+
+	// the rate isn't reduced by the limit of the device
+	require.Equal(t, 1000.0, w.limiter.Rate())
+
+	chOut, err = w.Send(context.Background(), &Request{
+		Devices: []string{"token1", "limited"},
+		Payload: &testRequest{},
+	})
+	require.NoError(t, err)
+
+	for range chOut {
+	}
+
+	// the rate is reduced by the provider answer
+	require.Equal(t, 1000*ratelimit.DecreaseFactor, w.limiter.Rate())
+}
+
+func TestWorkerSendCircuitOpen(t *testing.T) {
+
+	mu := sync.Mutex{}
+	countCalls := 0
+
+	w, err := New(
+		&Config{
+			Config:          &conversion.Config{},
+			ProjectID:       "project-id-123",
+			CountThreads:    1,
+			BreakerFailures: 2,
+			BreakerTimeout:  time.Minute,
+		},
+		KindApns,
+		false,
+		zap.NewNop(),
+		metric.New(),
+		func(context.Context, provider.IRequest) (*Result, error) {
+			mu.Lock()
+			countCalls++
+			mu.Unlock()
+
+			return nil, NewResponseErrorFromAnswer(503, errors.New("ServiceUnavailable"))
+		})
+	require.NoError(t, err)
+	require.NoError(t, w.Health())
+
+	chOut, err := w.Send(context.Background(), &Request{
+		Devices: []string{"token1", "token2", "token3", "token4"},
+		Payload: &testRequest{},
+	})
+	require.NoError(t, err)
+
+	countCircuitOpen := 0
+	for res := range chOut {
+		if res.Error == ErrCircuitOpen {
+			countCircuitOpen++
+		}
+		require.True(t, IsRetryable(res.Error))
+	}
+
+	// the notifications fail fast after 2 failures
+	require.Equal(t, 2, countCalls)
+	require.Equal(t, 2, countCircuitOpen)
+	require.Equal(t, ErrCircuitOpen, w.Health())
+
+	// the worker is healthy after the timeout: the probe is sent by the next request
+	w.breaker.now = func() time.Time { return time.Now().Add(time.Minute) }
+	require.NoError(t, w.Health())
+}
+
+func TestIsRetryable(t *testing.T) {
+
+	require.False(t, IsRetryable(nil))
+	require.True(t, IsRetryable(NewResponseErrorFromAnswer(503, errors.New("ServiceUnavailable"))))
+	require.True(t, IsRetryable(&url.Error{Op: "Post", URL: "https://fcm.googleapis.com", Err: io.ErrUnexpectedEOF}))
+	require.False(t, IsRetryable(NewResponseErrorFromAnswer(400, errors.New("BadRequest"))))
+
+	// the notification is canceled by the client or by the deadline of the push
+	require.False(t, IsRetryable(&url.Error{Op: "Post", URL: "https://fcm.googleapis.com", Err: context.Canceled}))
+	require.False(t, IsRetryable(context.DeadlineExceeded))
+}
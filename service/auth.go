@@ -0,0 +1,291 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/dialogs/dialog-push-service/pkg/api"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// apiKeyHeader is the gRPC metadata key of the client API key
+const apiKeyHeader = "x-api-key"
+
+// allProjects allows all projects to the client
+const allProjects = "*"
+
+// publicServices are available without the authentication: the probes and
+// the tools (grpcurl) don't have the API keys
+var publicServices = []string{
+	"/grpc.health.v1.Health/",
+	"/grpc.reflection.v1alpha.ServerReflection/",
+}
+
+// adminServices are available to the clients of all projects only.
+// The services are disabled if the clients aren't configured
+var adminServices = []string{
+	"/main.ProjectAdmin/",
+}
+
+var (
+	errUnauthenticated = status.Error(codes.Unauthenticated, "unknown client")
+	errAdminDenied     = status.Error(codes.PermissionDenied, "project admin: access denied")
+	errAdminDisabled   = status.Error(codes.PermissionDenied, "project admin: `clients` aren't configured")
+)
+
+// ClientConfig is a client of the service. The client is authenticated by
+// the API key or the identity of the client certificate (see TLSConfig).
+// All clients are allowed if the clients aren't configured
+type ClientConfig struct {
+	Name     string   `mapstructure:"name"`
+	APIKey   string   `mapstructure:"api-key"`
+	Identity string   `mapstructure:"identity"`
+	Projects []string `mapstructure:"projects"`
+}
+
+func NewClientConfig(src *viper.Viper) (*ClientConfig, error) {
+
+	c := &ClientConfig{}
+	if err := src.Unmarshal(c); err != nil {
+		return nil, err
+	}
+
+	if c.Name == "" {
+		return nil, errors.New("invalid `name`")
+	}
+
+	if c.APIKey == "" && c.Identity == "" {
+		return nil, errors.New("client " + c.Name + ": `api-key` or `identity` is required")
+	}
+
+	if len(c.Projects) == 0 {
+		return nil, errors.New("client " + c.Name + ": invalid `projects`")
+	}
+
+	return c, nil
+}
+
+// authClient is the authenticated client
+type authClient struct {
+	name     string
+	projects map[string]struct{}
+}
+
+// authorizeAdmin checks that the client may manage the projects
+func (c *authClient) authorizeAdmin() error {
+
+	if _, ok := c.projects[allProjects]; !ok {
+		return errAdminDenied
+	}
+
+	return nil
+}
+
+// authorize checks that the client may send the push to all projects of the push
+func (c *authClient) authorize(push *api.Push) error {
+
+	if _, ok := c.projects[allProjects]; ok {
+		return nil
+	}
+
+	for projectID := range push.GetDestinations() {
+		if _, ok := c.projects[projectID]; !ok {
+			return status.Error(codes.PermissionDenied, "project ID: "+projectID+": access denied")
+		}
+	}
+
+	return nil
+}
+
+type authClientKey struct{}
+
+// authorizePush checks the projects of the push by the client of the context.
+// All projects are allowed if the authorization is disabled
+func authorizePush(ctx context.Context, push *api.Push) error {
+
+	c, ok := ctx.Value(authClientKey{}).(*authClient)
+	if !ok || c == nil {
+		return nil
+	}
+
+	return c.authorize(push)
+}
+
+// authorizer authenticates the clients and checks the projects of the pushes.
+// The authorization is disabled if the clients aren't configured
+type authorizer struct {
+	byAPIKey   map[string]*authClient
+	byIdentity map[string]*authClient
+}
+
+func newAuthorizer(clients []*ClientConfig) (*authorizer, error) {
+
+	a := &authorizer{
+		byAPIKey:   make(map[string]*authClient),
+		byIdentity: make(map[string]*authClient),
+	}
+
+	names := make(map[string]struct{}, len(clients))
+	for _, cfg := range clients {
+		if _, ok := names[cfg.Name]; ok {
+			return nil, errors.New("not unique client name: " + cfg.Name)
+		}
+		names[cfg.Name] = struct{}{}
+
+		c := &authClient{
+			name:     cfg.Name,
+			projects: make(map[string]struct{}, len(cfg.Projects)),
+		}
+
+		for _, projectID := range cfg.Projects {
+			c.projects[projectID] = struct{}{}
+		}
+
+		if cfg.APIKey != "" {
+			if _, ok := a.byAPIKey[cfg.APIKey]; ok {
+				return nil, errors.New("client " + cfg.Name + ": not unique `api-key`")
+			}
+			a.byAPIKey[cfg.APIKey] = c
+		}
+
+		if cfg.Identity != "" {
+			if _, ok := a.byIdentity[cfg.Identity]; ok {
+				return nil, errors.New("client " + cfg.Name + ": not unique `identity`")
+			}
+			a.byIdentity[cfg.Identity] = c
+		}
+	}
+
+	return a, nil
+}
+
+func (a *authorizer) enabled() bool {
+	return len(a.byAPIKey) > 0 || len(a.byIdentity) > 0
+}
+
+// client returns the client by the identity of the certificate or by the API key
+func (a *authorizer) client(identity, apiKey string) (*authClient, error) {
+
+	if identity != "" {
+		if c, ok := a.byIdentity[identity]; ok {
+			return c, nil
+		}
+	}
+
+	if apiKey != "" {
+		if c, ok := a.byAPIKey[apiKey]; ok {
+			return c, nil
+		}
+	}
+
+	return nil, errUnauthenticated
+}
+
+// authenticate attaches the client identity and the client to the context. The client is nil
+// if the authorization is disabled
+func (a *authorizer) authenticate(ctx context.Context) (context.Context, *authClient, error) {
+
+	identity := peerIdentity(ctx)
+	if !a.enabled() {
+		return withClientIdentity(ctx, identity), nil, nil
+	}
+
+	apiKey := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(apiKeyHeader); len(values) > 0 {
+			apiKey = values[0]
+		}
+	}
+
+	c, err := a.client(identity, apiKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ctx = context.WithValue(ctx, authClientKey{}, c)
+	return withClientIdentity(ctx, c.name), c, nil
+}
+
+func (a *authorizer) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+
+	if info != nil && isPublicMethod(info.FullMethod) {
+		return handler(withClientIdentity(ctx, peerIdentity(ctx)), req)
+	}
+
+	ctx, c, err := a.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// the projects are managed by the authorized clients only
+	if info != nil && isAdminMethod(info.FullMethod) {
+		if c == nil {
+			return nil, errAdminDisabled
+		}
+
+		if err := c.authorizeAdmin(); err != nil {
+			return nil, err
+		}
+	}
+
+	if push, ok := req.(*api.Push); ok && c != nil {
+		if err := c.authorize(push); err != nil {
+			return nil, err
+		}
+	}
+
+	return handler(ctx, req)
+}
+
+func (a *authorizer) streamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+
+	if info != nil && isPublicMethod(info.FullMethod) {
+		return handler(srv, stream)
+	}
+
+	// the pushes of the stream are checked by the handler (see authorizePush):
+	// a push to a foreign project is rejected, the stream isn't closed
+	ctx, _, err := a.authenticate(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	return handler(srv, &serverStream{
+		ServerStream: stream,
+		ctx:          ctx,
+	})
+}
+
+func isPublicMethod(fullMethod string) bool {
+	return isServiceMethod(publicServices, fullMethod)
+}
+
+func isAdminMethod(fullMethod string) bool {
+	return isServiceMethod(adminServices, fullMethod)
+}
+
+func isServiceMethod(services []string, fullMethod string) bool {
+
+	for _, prefix := range services {
+		if strings.HasPrefix(fullMethod, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// serverStream is the stream with the context of the interceptor
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,188 @@
+package service
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+
+	"github.com/dialogs/dialog-push-service/pkg/api"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthorizer(t *testing.T) {
+
+	auth, err := newAuthorizer([]*ClientConfig{
+		{
+			Name:     "messenger",
+			APIKey:   "key-1",
+			Projects: []string{"p-1", "p-2"},
+		},
+		{
+			Name:     "calls",
+			Identity: "calls.dlg.im",
+			Projects: []string{"p-3"},
+		},
+		{
+			Name:     "admin",
+			APIKey:   "key-2",
+			Projects: []string{allProjects},
+		},
+	})
+	require.NoError(t, err)
+
+	handler := func(ctx context.Context, _ interface{}) (interface{}, error) {
+		return clientIdentity(ctx), nil
+	}
+
+	withAPIKey := func(key string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(apiKeyHeader, key))
+	}
+
+	withIdentity := func(commonName string) context.Context {
+		return peer.NewContext(context.Background(), &peer.Peer{
+			AuthInfo: credentials.TLSInfo{
+				State: tls.ConnectionState{
+					VerifiedChains: [][]*x509.Certificate{{{Subject: pkix.Name{CommonName: commonName}}}},
+				},
+			},
+		})
+	}
+
+	push := func(projects ...string) *api.Push {
+		retval := &api.Push{Destinations: make(map[string]*api.DeviceIdList)}
+		for _, projectID := range projects {
+			retval.Destinations[projectID] = &api.DeviceIdList{DeviceIds: []string{"token"}}
+		}
+		return retval
+	}
+
+	_, err = auth.unaryInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/grpc.health.v1.Health/Check"}, handler)
+	require.NoError(t, err)
+
+	for _, testCase := range []struct {
+		Name     string
+		Ctx      context.Context
+		Req      interface{}
+		Identity string
+		Code     codes.Code
+	}{
+		{"without credentials", context.Background(), &api.PingRequest{}, "", codes.Unauthenticated},
+		{"unknown key", withAPIKey("key-3"), &api.PingRequest{}, "", codes.Unauthenticated},
+		{"unknown identity", withIdentity("unknown.dlg.im"), &api.PingRequest{}, "", codes.Unauthenticated},
+		{"ping", withAPIKey("key-1"), &api.PingRequest{}, "messenger", codes.OK},
+		{"allowed projects", withAPIKey("key-1"), push("p-1", "p-2"), "messenger", codes.OK},
+		{"foreign project", withAPIKey("key-1"), push("p-1", "p-3"), "", codes.PermissionDenied},
+		{"identity", withIdentity("calls.dlg.im"), push("p-3"), "calls", codes.OK},
+		{"identity: foreign project", withIdentity("calls.dlg.im"), push("p-1"), "", codes.PermissionDenied},
+		{"all projects", withAPIKey("key-2"), push("p-1", "p-3", "p-4"), "admin", codes.OK},
+	} {
+		t.Run(testCase.Name, func(t *testing.T) {
+			identity, err := auth.unaryInterceptor(testCase.Ctx, testCase.Req, nil, handler)
+			require.Equal(t, testCase.Code, status.Code(err), err)
+			if err == nil {
+				require.Equal(t, testCase.Identity, identity)
+			}
+		})
+	}
+
+	// the projects are managed by the clients of all projects only
+	adminInfo := &grpc.UnaryServerInfo{FullMethod: "/main.ProjectAdmin/PutProject"}
+
+	_, err = auth.unaryInterceptor(withAPIKey("key-1"), &api.ProjectSpec{ProjectId: "p-1"}, adminInfo, handler)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	identity, err := auth.unaryInterceptor(withAPIKey("key-2"), &api.ProjectSpec{ProjectId: "p-1"}, adminInfo, handler)
+	require.NoError(t, err)
+	require.Equal(t, "admin", identity)
+
+	// the projects aren't managed if the clients aren't configured
+	disabled, err := newAuthorizer(nil)
+	require.NoError(t, err)
+
+	_, err = disabled.unaryInterceptor(context.Background(), &api.ProjectSpec{ProjectId: "p-1"}, adminInfo, handler)
+	require.Equal(t, errAdminDisabled, err)
+}
+
+func TestAuthorizerStream(t *testing.T) {
+
+	auth, err := newAuthorizer([]*ClientConfig{
+		{
+			Name:     "messenger",
+			APIKey:   "key-1",
+			Projects: []string{"p-1"},
+		},
+	})
+	require.NoError(t, err)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(apiKeyHeader, "key-1"))
+	stream := &fakeServerStream{
+		ctx: ctx,
+		pushes: []*api.Push{
+			{Destinations: map[string]*api.DeviceIdList{"p-1": {}}},
+			{Destinations: map[string]*api.DeviceIdList{"p-2": {}}},
+		},
+	}
+
+	denied := make([]codes.Code, 0)
+	err = auth.streamInterceptor(nil, stream, nil, func(_ interface{}, stream grpc.ServerStream) error {
+		require.Equal(t, "messenger", clientIdentity(stream.Context()))
+
+		for {
+			push := &api.Push{}
+			if err := stream.RecvMsg(push); err != nil {
+				return err
+			}
+
+			denied = append(denied, status.Code(authorizePush(stream.Context(), push)))
+		}
+	})
+	// the stream isn't closed by the push to a foreign project
+	require.Equal(t, codes.Canceled, status.Code(err))
+	require.Equal(t, []codes.Code{codes.OK, codes.PermissionDenied}, denied)
+
+	// all projects are allowed if the authorization is disabled
+	require.NoError(t, authorizePush(context.Background(), &api.Push{Destinations: map[string]*api.DeviceIdList{"p-2": {}}}))
+
+	stream = &fakeServerStream{ctx: context.Background()}
+	err = auth.streamInterceptor(nil, stream, nil, func(interface{}, grpc.ServerStream) error {
+		require.Fail(t, "unknown client")
+		return nil
+	})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// the health checks are available without the credentials
+	err = auth.streamInterceptor(nil, stream, &grpc.StreamServerInfo{FullMethod: "/grpc.health.v1.Health/Watch"}, func(interface{}, grpc.ServerStream) error {
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	pushes []*api.Push
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeServerStream) RecvMsg(m interface{}) error {
+
+	if len(s.pushes) == 0 {
+		return status.Error(codes.Canceled, "end of stream")
+	}
+
+	*m.(*api.Push) = *s.pushes[0]
+	s.pushes = s.pushes[1:]
+
+	return nil
+}
@@ -1,22 +1,34 @@
 package service
 
 import (
-	"errors"
 	"fmt"
+	"time"
 
+	"github.com/dialogs/dialog-push-service/pkg/retry"
 	"github.com/dialogs/dialog-push-service/pkg/worker"
 	"github.com/dialogs/dialog-push-service/pkg/worker/ans"
 	"github.com/dialogs/dialog-push-service/pkg/worker/fcm"
 	"github.com/dialogs/dialog-push-service/pkg/worker/gcm"
+	"github.com/pkg/errors"
 	"github.com/spf13/viper"
 )
 
+// DefaultStreamMaxInFlight is the default limit of the pushes in flight per PushStream
+const DefaultStreamMaxInFlight = 100
+
 type Config struct {
-	Fcm       []*fcm.Config `mapstructure:"-"`
-	Gcm       []*gcm.Config `mapstructure:"-"`
-	Ans       []*ans.Config `mapstructure:"-"`
-	ApiPort   string        `mapstructure:"grpc-port"`
-	AdminPort string        `mapstructure:"http-port"`
+	Fcm               []*fcm.Config   `mapstructure:"-"`
+	Gcm               []*gcm.Config   `mapstructure:"-"`
+	Ans               []*ans.Config   `mapstructure:"-"`
+	Retry             *retry.Config   `mapstructure:"-"`
+	GRPCTLS           *TLSConfig      `mapstructure:"-"`
+	Clients           []*ClientConfig `mapstructure:"-"`
+	ApiPort           string          `mapstructure:"grpc-port"`
+	AdminPort         string          `mapstructure:"http-port"`
+	RestPort          string          `mapstructure:"rest-port"`
+	StreamMaxInFlight int             `mapstructure:"stream-max-in-flight"`
+	ShutdownTimeout   time.Duration   `mapstructure:"shutdown-timeout"`
+	ProjectsDir       string          `mapstructure:"projects-dir"`
 }
 
 func NewConfig(src *viper.Viper) (*Config, error) {
@@ -27,6 +39,14 @@ func NewConfig(src *viper.Viper) (*Config, error) {
 		return nil, err
 	}
 
+	if c.StreamMaxInFlight < 0 {
+		return nil, errors.New("invalid `stream-max-in-flight`")
+	}
+
+	if c.ShutdownTimeout < 0 {
+		return nil, errors.New("invalid `shutdown-timeout`")
+	}
+
 	c.Ans, err = getAppleConfig(src)
 	if err != nil {
 		return nil, err
@@ -42,6 +62,21 @@ func NewConfig(src *viper.Viper) (*Config, error) {
 		return nil, err
 	}
 
+	c.Retry, err = retry.NewConfig(src.Sub("retry"))
+	if err != nil {
+		return nil, errors.Wrap(err, "retry")
+	}
+
+	c.GRPCTLS, err = NewTLSConfig(src.Sub("grpc-tls"))
+	if err != nil {
+		return nil, errors.Wrap(err, "grpc-tls")
+	}
+
+	c.Clients, err = getClientsConfig(src)
+	if err != nil {
+		return nil, errors.Wrap(err, "clients")
+	}
+
 	return c, nil
 }
 
@@ -128,6 +163,26 @@ func getAppleConfig(src *viper.Viper) ([]*ans.Config, error) {
 	return retval, nil
 }
 
+func getClientsConfig(src *viper.Viper) ([]*ClientConfig, error) {
+
+	srcList, err := getConfigListByKey(src, "clients")
+	if err != nil {
+		return nil, err
+	}
+
+	retval := make([]*ClientConfig, 0, len(srcList))
+	for _, item := range srcList {
+		cfg, err := NewClientConfig(item)
+		if err != nil {
+			return nil, err
+		}
+
+		retval = append(retval, cfg)
+	}
+
+	return retval, nil
+}
+
 func getConfigListByKey(src *viper.Viper, key string) ([]*viper.Viper, error) {
 
 	sub := src.Get(key)
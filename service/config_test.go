@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/dialogs/dialog-push-service/pkg/conversion"
+	"github.com/dialogs/dialog-push-service/pkg/retry"
 	"github.com/dialogs/dialog-push-service/pkg/test"
 	"github.com/dialogs/dialog-push-service/pkg/worker"
 	"github.com/dialogs/dialog-push-service/pkg/worker/ans"
@@ -18,17 +19,21 @@ import (
 
 func TestConfig(t *testing.T) {
 
-	applePem, err := test.GetPathToIOSCertificatePem()
+	pem, err := test.NewAppleCertificatePem(test.AppleCertificate{Production: true})
 	require.NoError(t, err)
 
-	gcmKey, err := test.GetAccountKey()
+	applePem, err := test.SaveTempFile(pem, "config")
 	require.NoError(t, err)
+	defer func() { require.NoError(t, os.Remove(applePem)) }()
 
-	fcmServiceAccount, err := test.GetPathToGoogleServiceAccount()
+	fcmServiceAccount, err := test.SaveTempFile([]byte("{}"), "config")
 	require.NoError(t, err)
+	defer func() { require.NoError(t, os.Remove(fcmServiceAccount)) }()
+
+	gcmKey := "server-key"
 
 	const file = "config.yaml"
-	fileData := getConfigSrc(t, applePem, string(gcmKey), fcmServiceAccount)
+	fileData := getConfigSrc(t, applePem, gcmKey, fcmServiceAccount)
 	require.NoError(t, ioutil.WriteFile(file, []byte(fileData), os.ModePerm))
 	defer func() { require.NoError(t, os.Remove(file)) }()
 
@@ -40,8 +45,12 @@ func TestConfig(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t,
 		&Config{
-			ApiPort:   "8010",
-			AdminPort: "8011",
+			ApiPort:           "8010",
+			AdminPort:         "8011",
+			RestPort:          "8012",
+			StreamMaxInFlight: 50,
+			ShutdownTimeout:   10 * time.Second,
+			ProjectsDir:       "/var/lib/push/projects",
 			Fcm: []*fcm.Config{
 				{
 					ServiceAccount: fcmServiceAccount,
@@ -52,6 +61,8 @@ func TestConfig(t *testing.T) {
 						NopMode:      true,
 						CountThreads: 4,
 						Sandbox:      true,
+						RateLimit:    500,
+						RateBurst:    50,
 						Config: &conversion.Config{
 							AllowAlerts: true,
 						},
@@ -64,10 +75,14 @@ func TestConfig(t *testing.T) {
 					Retries:   10,
 					Timeout:   2 * time.Second,
 					Config: &worker.Config{
-						ProjectID:    "p-2",
-						NopMode:      true,
-						CountThreads: 3,
-						Sandbox:      true,
+						ProjectID:       "p-2",
+						NopMode:         true,
+						CountThreads:    3,
+						Sandbox:         true,
+						QueueSize:       20,
+						QueueTimeout:    500 * time.Millisecond,
+						BreakerFailures: 5,
+						BreakerTimeout:  time.Minute,
 						Config: &conversion.Config{
 							AllowAlerts: true,
 						},
@@ -76,14 +91,21 @@ func TestConfig(t *testing.T) {
 			},
 			Ans: []*ans.Config{
 				{
-					PemFile: applePem,
-					Retries: 10,
-					Timeout: 2 * time.Second,
+					PemFile:           applePem,
+					Voip:              true,
+					Retries:           10,
+					Timeout:           2 * time.Second,
+					ExpiryWarningDays: []int{14, 3},
 					Config: &worker.Config{
-						ProjectID:    "p-3",
-						NopMode:      true,
-						CountThreads: 2,
-						Sandbox:      true,
+						ProjectID:           "p-3",
+						NopMode:             true,
+						CountThreads:        2,
+						Sandbox:             true,
+						HighPriorityThreads: 2,
+						RetryBaseDelay:      200 * time.Millisecond,
+						RetryMaxDelay:       10 * time.Second,
+						RetryJitter:         0.2,
+						RetryMaxElapsed:     time.Minute,
 						Config: &conversion.Config{
 							AllowAlerts: true,
 							Topic:       "im.dlg.dialog-ee",
@@ -92,6 +114,33 @@ func TestConfig(t *testing.T) {
 					},
 				},
 			},
+			Retry: &retry.Config{
+				Dir:            "/var/lib/push/retry",
+				BaseDelay:      2 * time.Second,
+				MaxDelay:       time.Minute,
+				MaxAge:         retry.DefaultMaxAge,
+				PollInterval:   retry.DefaultPollInterval,
+				MaxConcurrency: retry.DefaultMaxConcurrency,
+				BatchSize:      retry.DefaultBatchSize,
+			},
+			GRPCTLS: &TLSConfig{
+				CertFile:     "/etc/push/server.pem",
+				KeyFile:      "/etc/push/server.key",
+				ClientCAFile: "/etc/push/clients-ca.pem",
+				ClientAuth:   ClientAuthVerifyIfGiven,
+			},
+			Clients: []*ClientConfig{
+				{
+					Name:     "messenger",
+					APIKey:   "key-1",
+					Projects: []string{"p-1", "p-2"},
+				},
+				{
+					Name:     "calls",
+					Identity: "calls.dlg.im",
+					Projects: []string{"*"},
+				},
+			},
 		},
 		cfg)
 }
@@ -102,6 +151,10 @@ func getConfigSrc(t *testing.T, applePem, gcmKey, fcmServiceAccount string) stri
 	return `
 grpc-port: 8010
 http-port: 8011
+rest-port: 8012
+stream-max-in-flight: 50
+shutdown-timeout: 10s
+projects-dir: /var/lib/push/projects
 fcm:
   - project-id: p-1
     service-account: ` + fcmServiceAccount + `
@@ -111,6 +164,8 @@ fcm:
     allow-alerts: true
     sandbox: true
     workers: 4
+    rate-limit: 500
+    rate-burst: 50
 google:
   - project-id: p-2
     key: ` + gcmKey + `
@@ -120,6 +175,10 @@ google:
     allow-alerts: true
     sandbox: true
     workers: 3
+    queue-size: 20
+    queue-timeout: 500ms
+    breaker-failures: 5
+    breaker-timeout: 1m
 apple:
   - project-id: p-3
     topic: im.dlg.dialog-ee
@@ -132,5 +191,30 @@ apple:
     pem: ` + applePem + `
     sound: "dialog.wav"
     workers: 2
+    high-priority-workers: 2
+    retry-base-delay: 200ms
+    retry-max-delay: 10s
+    retry-jitter: 0.2
+    retry-max-elapsed: 1m
+    expiry-warning-days: [14, 3]
+retry:
+  dir: /var/lib/push/retry
+  base-delay: 2s
+  max-delay: 1m
+grpc-tls:
+  cert-file: /etc/push/server.pem
+  key-file: /etc/push/server.key
+  client-ca-file: /etc/push/clients-ca.pem
+  client-auth: verify-if-given
+clients:
+  - name: messenger
+    api-key: key-1
+    projects:
+      - p-1
+      - p-2
+  - name: calls
+    identity: calls.dlg.im
+    projects:
+      - "*"
 `
 }
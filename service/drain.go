@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/dialogs/dialog-push-service/pkg/worker"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultShutdownTimeout is the default time of waiting for the pushes in flight on shutdown
+const DefaultShutdownTimeout = 30 * time.Second
+
+var (
+	errShuttingDown = status.Error(codes.Unavailable, "service is shutting down")
+	errDrainTimeout = errors.New("drain timeout: the pushes in flight are canceled")
+)
+
+// drainer tracks the pushes in flight. On shutdown the new pushes are rejected
+// and the pushes in flight are canceled after the timeout
+type drainer struct {
+	mu       sync.RWMutex
+	draining bool
+	inFlight sync.WaitGroup
+	ctxAbort context.Context
+	abort    func()
+}
+
+func newDrainer() *drainer {
+
+	ctxAbort, abort := context.WithCancel(context.Background())
+
+	return &drainer{
+		ctxAbort: ctxAbort,
+		abort:    abort,
+	}
+}
+
+// begin registers the push in flight. The context of the push is canceled by the parent
+// context or by the shutdown timeout. The push is finished by the cancel function.
+// The push is rejected (false) if the service is shutting down
+func (d *drainer) begin(parent context.Context) (context.Context, func(), bool) {
+
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	if d.draining {
+		return nil, nil, false
+	}
+
+	d.inFlight.Add(1)
+
+	ctx, cancel := context.WithCancel(parent)
+	go func() {
+		select {
+		case <-d.ctxAbort.Done():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	once := sync.Once{}
+	return ctx, func() {
+		once.Do(func() {
+			cancel()
+			d.inFlight.Done()
+		})
+	}, true
+}
+
+// aborted returns true if the pushes in flight are canceled by the shutdown
+func (d *drainer) aborted() bool {
+	return d.ctxAbort.Err() != nil
+}
+
+// drain rejects the new pushes and waits for the pushes in flight. After the timeout
+// the pushes are canceled: the notifications that aren't sent are stored for retry
+func (d *drainer) drain(timeout time.Duration) error {
+
+	d.mu.Lock()
+	d.draining = true
+	d.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		d.inFlight.Wait()
+		close(done)
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case <-done:
+		return nil
+	case <-timer.C:
+	}
+
+	d.abort()
+	<-done
+
+	return errDrainTimeout
+}
+
+// appendCanceledResponses adds the canceled responses of the devices without the responses
+func appendCanceledResponses(responses []*worker.Response, projectID string, devices []string) []*worker.Response {
+
+	count := make(map[string]int, len(responses))
+	for _, res := range responses {
+		count[res.DeviceToken]++
+	}
+
+	for _, device := range devices {
+		if count[device] > 0 {
+			count[device]--
+			continue
+		}
+
+		responses = append(responses, &worker.Response{
+			ProjectID:   projectID,
+			DeviceToken: device,
+			Error:       context.Canceled,
+		})
+	}
+
+	return responses
+}
+
+// isCanceled returns true if the notification is canceled: the context error
+// is wrapped by the provider clients (errors.Wrap) or by the HTTP client (*url.Error)
+func isCanceled(err error) bool {
+
+	cause := errors.Cause(err)
+	if e, ok := cause.(*url.Error); ok {
+		cause = errors.Cause(e.Err)
+	}
+
+	return cause == context.Canceled
+}
@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/dialogs/dialog-push-service/pkg/api"
+	"github.com/dialogs/dialog-push-service/pkg/conversion"
+	"github.com/dialogs/dialog-push-service/pkg/metric"
+	"github.com/dialogs/dialog-push-service/pkg/retry"
+	"github.com/dialogs/dialog-push-service/pkg/worker"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestDrainer(t *testing.T) {
+
+	d := newDrainer()
+
+	ctx, cancel, ok := d.begin(context.Background())
+	require.True(t, ok)
+
+	// the push is finished before the timeout
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		cancel()
+	}()
+
+	require.NoError(t, d.drain(time.Second))
+	require.False(t, d.aborted())
+	require.Error(t, ctx.Err())
+
+	// the new pushes are rejected
+	_, _, ok = d.begin(context.Background())
+	require.False(t, ok)
+
+	d = newDrainer()
+
+	ctx, cancel, ok = d.begin(context.Background())
+	require.True(t, ok)
+
+	// the push is canceled by the timeout
+	go func() {
+		<-ctx.Done()
+		cancel()
+	}()
+
+	require.Equal(t, errDrainTimeout, d.drain(50*time.Millisecond))
+	require.True(t, d.aborted())
+}
+
+func TestDrainSpill(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "retry")
+	require.NoError(t, err)
+	defer func() { require.NoError(t, os.RemoveAll(dir)) }()
+
+	retryCfg, err := retry.NewConfig(nil)
+	require.NoError(t, err)
+	retryCfg.Dir = dir
+
+	retryQueue, err := retry.Open(retryCfg, zap.NewNop(), metric.New())
+	require.NoError(t, err)
+
+	impl := &implGRPC{
+		metric:  metric.New(),
+		workers: map[string]worker.IWorker{"p-1": &stuckWorker{}},
+		logger:  zap.NewNop(),
+		retry:   retryQueue,
+		drainer: newDrainer(),
+	}
+
+	chRes := make(chan *api.Response)
+	go func() {
+		res, err := impl.singlePush(context.Background(), &api.Push{
+			Destinations: map[string]*api.DeviceIdList{"p-1": {DeviceIds: []string{"token1", "token2"}}},
+			Body:         &api.PushBody{Body: &api.PushBody_SilentPush{SilentPush: &api.SilentPush{}}},
+		}, zap.NewNop())
+		require.NoError(t, err)
+		chRes <- res
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+	require.Equal(t, errDrainTimeout, impl.drainer.drain(50*time.Millisecond))
+
+	// the notifications that aren't sent are stored for retry
+	res := <-chRes
+	require.Len(t, res.Results["p-1"].GetResults(), 2)
+	for _, deviceRes := range res.Results["p-1"].Results {
+		require.Equal(t, api.StatusRetryQueued, deviceRes.Status, deviceRes.DeviceId)
+	}
+	require.Equal(t, 2, retryQueue.Len())
+
+	// the pushes are rejected after the shutdown
+	_, err = impl.singlePush(context.Background(), &api.Push{}, zap.NewNop())
+	require.Equal(t, errShuttingDown, err)
+}
+
+func TestAppendCanceledResponses(t *testing.T) {
+
+	responses := appendCanceledResponses(
+		[]*worker.Response{{ProjectID: "p-1", DeviceToken: "token1"}},
+		"p-1",
+		[]string{"token1", "token2", "token1"})
+
+	require.Equal(t,
+		[]*worker.Response{
+			{ProjectID: "p-1", DeviceToken: "token1"},
+			{ProjectID: "p-1", DeviceToken: "token2", Error: context.Canceled},
+			{ProjectID: "p-1", DeviceToken: "token1", Error: context.Canceled},
+		},
+		responses)
+}
+
+func TestIsCanceled(t *testing.T) {
+
+	require.True(t, isCanceled(context.Canceled))
+	require.True(t, isCanceled(errors.Wrap(context.Canceled, "jwt token")))
+	require.True(t, isCanceled(&url.Error{Op: "Post", URL: "https://fcm.googleapis.com", Err: context.Canceled}))
+
+	require.False(t, isCanceled(nil))
+	require.False(t, isCanceled(context.DeadlineExceeded))
+	require.False(t, isCanceled(errors.New("unavailable")))
+}
+
+// stuckWorker admits the requests, but doesn't send the notifications
+// until the request is canceled
+type stuckWorker struct {
+	worker.IWorker
+}
+
+func (w *stuckWorker) Kind() worker.Kind {
+	return worker.KindGcm
+}
+
+func (w *stuckWorker) ProjectID() string {
+	return "p-1"
+}
+
+func (w *stuckWorker) ConversionConfig() *conversion.Config {
+	return &conversion.Config{}
+}
+
+func (w *stuckWorker) Send(ctx context.Context, _ *worker.Request) (<-chan *worker.Response, error) {
+	return stuckAdmission{}.Send(ctx), nil
+}
+
+func (w *stuckWorker) Admit(context.Context, *worker.Request) (worker.Admission, error) {
+	return stuckAdmission{}, nil
+}
+
+type stuckAdmission struct{}
+
+func (stuckAdmission) Send(ctx context.Context) <-chan *worker.Response {
+
+	ch := make(chan *worker.Response)
+	go func() {
+		defer close(ch)
+		<-ctx.Done()
+	}()
+
+	return ch
+}
+
+func (stuckAdmission) Release() {}
@@ -0,0 +1,223 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+
+	"github.com/dialogs/dialog-push-service/pkg/api"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	restPushPath = "/v1/push"
+	restPingPath = "/v1/ping"
+	// restAPIKeyHeader is the header of the client API key (see apiKeyHeader)
+	restAPIKeyHeader = "X-Api-Key"
+	// restMaxBodySize is the limit of the push size
+	restMaxBodySize = 4 << 20
+)
+
+// restGateway is the REST/JSON API of the Pushing service. The requests and the responses
+// use the protobuf JSON mapping (jsonpb). The requests are authorized as the gRPC requests
+type restGateway struct {
+	impl   api.PushingServer
+	auth   *authorizer
+	logger *zap.Logger
+	mux    *http.ServeMux
+}
+
+// restError is the body of the failed request
+type restError struct {
+	Code    codes.Code `json:"code"`
+	Message string     `json:"message"`
+}
+
+func newRESTGateway(impl api.PushingServer, auth *authorizer, logger *zap.Logger) *restGateway {
+
+	g := &restGateway{
+		impl:   impl,
+		auth:   auth,
+		logger: logger.With(zap.String("component", "rest gateway")),
+		mux:    http.NewServeMux(),
+	}
+
+	g.mux.HandleFunc(restPushPath, g.push)
+	g.mux.HandleFunc(restPingPath, g.ping)
+
+	return g
+}
+
+func (g *restGateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	g.mux.ServeHTTP(w, r)
+}
+
+// push maps `POST /v1/push` to SinglePush
+func (g *restGateway) push(w http.ResponseWriter, r *http.Request) {
+
+	if r.Method != http.MethodPost {
+		writeMethodNotAllowed(w, http.MethodPost, g.logger)
+		return
+	}
+
+	push := &api.Push{}
+	unmarshaler := jsonpb.Unmarshaler{}
+	if err := unmarshaler.Unmarshal(io.LimitReader(r.Body, restMaxBodySize), push); err != nil {
+		writeError(w, status.Error(codes.InvalidArgument, "invalid push: "+err.Error()), g.logger)
+		return
+	}
+
+	res, err := interceptHTTP(g.auth, r, "/main.Pushing/SinglePush", push, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return g.impl.SinglePush(ctx, req.(*api.Push))
+	})
+	if err != nil {
+		writeError(w, err, g.logger)
+		return
+	}
+
+	writeMessage(w, res.(*api.Response), g.logger)
+}
+
+// ping maps `GET /v1/ping` to Ping
+func (g *restGateway) ping(w http.ResponseWriter, r *http.Request) {
+
+	if r.Method != http.MethodGet {
+		writeMethodNotAllowed(w, http.MethodGet, g.logger)
+		return
+	}
+
+	res, err := interceptHTTP(g.auth, r, "/main.Pushing/Ping", &api.PingRequest{}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return g.impl.Ping(ctx, req.(*api.PingRequest))
+	})
+	if err != nil {
+		writeError(w, err, g.logger)
+		return
+	}
+
+	writeMessage(w, res.(*api.PongResponse), g.logger)
+}
+
+// interceptHTTP calls the method by the interceptor of the gRPC server: the context
+// of the request has the API key and the peer (address and TLS state)
+func interceptHTTP(auth *authorizer, r *http.Request, method string, req interface{}, handler grpc.UnaryHandler) (interface{}, error) {
+
+	ctx := r.Context()
+
+	if apiKey := r.Header.Get(restAPIKeyHeader); apiKey != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(apiKeyHeader, apiKey))
+	}
+
+	p := &peer.Peer{Addr: restAddr(r.RemoteAddr)}
+	if r.TLS != nil {
+		p.AuthInfo = credentials.TLSInfo{State: *r.TLS}
+	}
+	ctx = peer.NewContext(ctx, p)
+
+	return auth.unaryInterceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+}
+
+// writeMessage writes the message in the protobuf JSON mapping
+func writeMessage(w http.ResponseWriter, msg proto.Message, l *zap.Logger) {
+
+	buf := bytes.NewBuffer(nil)
+	marshaler := jsonpb.Marshaler{}
+	if err := marshaler.Marshal(buf, msg); err != nil {
+		writeError(w, status.Error(codes.Internal, "response to json: "+err.Error()), l)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, buf.Bytes(), l)
+}
+
+func writeMethodNotAllowed(w http.ResponseWriter, allowed string, l *zap.Logger) {
+
+	w.Header().Set("Allow", allowed)
+
+	data, _ := json.Marshal(&restError{
+		Code:    codes.Unimplemented,
+		Message: "method not allowed",
+	})
+
+	writeJSON(w, http.StatusMethodNotAllowed, data, l)
+}
+
+// writeError writes the status of the gRPC error with the HTTP status code of the status
+func writeError(w http.ResponseWriter, err error, l *zap.Logger) {
+
+	st := status.Convert(err)
+
+	data, err := json.Marshal(&restError{
+		Code:    st.Code(),
+		Message: st.Message(),
+	})
+	if err != nil {
+		l.Error("error to json", zap.Error(err))
+	}
+
+	writeJSON(w, httpStatusFromCode(st.Code()), data, l)
+}
+
+func writeJSON(w http.ResponseWriter, code int, body []byte, l *zap.Logger) {
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+
+	if _, err := w.Write(body); err != nil {
+		l.Error("write response", zap.Error(err))
+	}
+}
+
+// httpStatusFromCode maps the gRPC status code to the HTTP status code
+func httpStatusFromCode(code codes.Code) int {
+
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return 499
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	}
+
+	return http.StatusInternalServerError
+}
+
+// restAddr is the address of the REST client
+type restAddr string
+
+var _ net.Addr = restAddr("")
+
+func (a restAddr) Network() string {
+	return "tcp"
+}
+
+func (a restAddr) String() string {
+	return string(a)
+}
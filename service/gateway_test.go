@@ -0,0 +1,163 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/dialogs/dialog-push-service/pkg/api"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRESTGateway(t *testing.T) {
+
+	auth, err := newAuthorizer([]*ClientConfig{
+		{
+			Name:     "messenger",
+			APIKey:   "key-1",
+			Projects: []string{"p-1"},
+		},
+	})
+	require.NoError(t, err)
+
+	impl := &gatewayPushing{}
+	server := httptest.NewServer(newRESTGateway(impl, auth, zap.NewNop()))
+	defer server.Close()
+
+	do := func(method, path, apiKey, body string) (*http.Response, []byte) {
+		t.Helper()
+
+		req, err := http.NewRequest(method, server.URL+path, strings.NewReader(body))
+		require.NoError(t, err)
+
+		if apiKey != "" {
+			req.Header.Set("X-Api-Key", apiKey)
+		}
+
+		res, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer res.Body.Close()
+
+		data, err := ioutil.ReadAll(res.Body)
+		require.NoError(t, err)
+
+		return res, data
+	}
+
+	requireError := func(res *http.Response, body []byte, httpCode int, code codes.Code) {
+		t.Helper()
+
+		require.Equal(t, httpCode, res.StatusCode, string(body))
+
+		resErr := &restError{}
+		require.NoError(t, json.Unmarshal(body, resErr))
+		require.Equal(t, code, resErr.Code)
+	}
+
+	t.Run("ping", func(t *testing.T) {
+		res, body := do(http.MethodGet, "/v1/ping", "key-1", "")
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		require.Equal(t, "application/json", res.Header.Get("Content-Type"))
+		require.JSONEq(t, `{}`, string(body))
+
+		res, body = do(http.MethodPost, "/v1/ping", "key-1", "")
+		require.Equal(t, http.StatusMethodNotAllowed, res.StatusCode, string(body))
+		require.Equal(t, http.MethodGet, res.Header.Get("Allow"))
+	})
+
+	t.Run("push", func(t *testing.T) {
+		res, body := do(http.MethodPost, "/v1/push", "key-1", `{
+			"destinations": {"p-1": {"deviceIds": ["token-1"]}},
+			"body": {"seq": 1, "silentPush": {}},
+			"correlationId": "c-1"
+		}`)
+		require.Equal(t, http.StatusOK, res.StatusCode, string(body))
+
+		pushRes := &api.Response{}
+		require.NoError(t, jsonpb.UnmarshalString(string(body), pushRes))
+		require.Equal(t, &api.Response{
+			Results: map[string]*api.DeviceResultList{
+				"p-1": {Results: []*api.DeviceResult{{DeviceId: "token-1", Status: api.StatusDelivered}}},
+			},
+		}, pushRes)
+
+		require.Equal(t, "c-1", impl.push.CorrelationId)
+		require.Equal(t, int32(1), impl.push.GetBody().GetSeq())
+		require.Equal(t, "messenger", impl.client)
+	})
+
+	t.Run("push: unknown client", func(t *testing.T) {
+		res, body := do(http.MethodPost, "/v1/push", "key-2", `{"destinations": {"p-1": {}}}`)
+		requireError(res, body, http.StatusUnauthorized, codes.Unauthenticated)
+
+		res, body = do(http.MethodGet, "/v1/ping", "", "")
+		requireError(res, body, http.StatusUnauthorized, codes.Unauthenticated)
+	})
+
+	t.Run("push: foreign project", func(t *testing.T) {
+		res, body := do(http.MethodPost, "/v1/push", "key-1", `{"destinations": {"p-2": {}}}`)
+		requireError(res, body, http.StatusForbidden, codes.PermissionDenied)
+	})
+
+	t.Run("push: invalid json", func(t *testing.T) {
+		res, body := do(http.MethodPost, "/v1/push", "key-1", `{"unknown": 1}`)
+		requireError(res, body, http.StatusBadRequest, codes.InvalidArgument)
+
+		res, body = do(http.MethodPost, "/v1/push", "key-1", `[`)
+		requireError(res, body, http.StatusBadRequest, codes.InvalidArgument)
+	})
+
+	t.Run("push: server error", func(t *testing.T) {
+		impl.err = status.Error(codes.ResourceExhausted, "worker queue is full")
+
+		res, body := do(http.MethodPost, "/v1/push", "key-1", `{"destinations": {"p-1": {}}}`)
+		requireError(res, body, http.StatusTooManyRequests, codes.ResourceExhausted)
+	})
+}
+
+// gatewayPushing is the Pushing service with the constant result
+type gatewayPushing struct {
+	api.PushingServer
+	push   *api.Push
+	client string
+	err    error
+}
+
+func (p *gatewayPushing) Ping(context.Context, *api.PingRequest) (*api.PongResponse, error) {
+	return &api.PongResponse{}, nil
+}
+
+func (p *gatewayPushing) SinglePush(ctx context.Context, push *api.Push) (*api.Response, error) {
+
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	p.push = push
+	p.client = clientIdentity(ctx)
+
+	res := &api.Response{
+		Results: make(map[string]*api.DeviceResultList),
+	}
+
+	for projectID, devices := range push.Destinations {
+		results := &api.DeviceResultList{}
+		for _, deviceID := range devices.DeviceIds {
+			results.Results = append(results.Results, &api.DeviceResult{
+				DeviceId: deviceID,
+				Status:   api.StatusDelivered,
+			})
+		}
+		res.Results[projectID] = results
+	}
+
+	return res, nil
+}
@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/dialogs/dialog-push-service/pkg/worker"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	// pushingServiceName is the name of the Pushing service in the health checks
+	pushingServiceName  = "main.Pushing"
+	healthCheckInterval = 5 * time.Second
+)
+
+// healthChecker updates the statuses of the standard gRPC health checking.
+// The service name of a project is the project ID: the project is SERVING while
+// the worker is healthy. The server ("") and the Pushing service are NOT_SERVING
+// if all workers are unhealthy. The status of a removed project is SERVICE_UNKNOWN
+type healthChecker struct {
+	mu       sync.Mutex
+	server   *health.Server
+	workers  func() map[string]worker.IWorker
+	logger   *zap.Logger
+	statuses map[string]healthpb.HealthCheckResponse_ServingStatus
+}
+
+func newHealthChecker(workers func() map[string]worker.IWorker, logger *zap.Logger) *healthChecker {
+
+	h := &healthChecker{
+		server:   health.NewServer(),
+		workers:  workers,
+		logger:   logger.With(zap.String("component", "health")),
+		statuses: make(map[string]healthpb.HealthCheckResponse_ServingStatus),
+	}
+	h.check()
+
+	return h
+}
+
+// run checks the workers until the context is done
+func (h *healthChecker) run(ctx context.Context) {
+
+	ticker := time.NewTicker(healthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			h.check()
+		}
+	}
+}
+
+func (h *healthChecker) check() {
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	workers := h.workers()
+
+	serving := len(workers) == 0
+	for projectID, w := range workers {
+		status := healthpb.HealthCheckResponse_SERVING
+		if err := w.Health(); err != nil {
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+		} else {
+			serving = true
+		}
+
+		h.setStatus(projectID, status)
+	}
+
+	for service := range h.statuses {
+		if _, ok := workers[service]; !ok && service != "" && service != pushingServiceName {
+			h.setStatus(service, healthpb.HealthCheckResponse_SERVICE_UNKNOWN)
+			delete(h.statuses, service)
+		}
+	}
+
+	status := healthpb.HealthCheckResponse_NOT_SERVING
+	if serving {
+		status = healthpb.HealthCheckResponse_SERVING
+	}
+
+	h.setStatus("", status)
+	h.setStatus(pushingServiceName, status)
+}
+
+func (h *healthChecker) setStatus(service string, status healthpb.HealthCheckResponse_ServingStatus) {
+
+	if prev, ok := h.statuses[service]; ok && prev == status {
+		return
+	}
+
+	if _, ok := h.statuses[service]; ok || status != healthpb.HealthCheckResponse_SERVING {
+		h.logger.Warn("health status", zap.String("service", service), zap.Stringer("status", status))
+	}
+
+	h.statuses[service] = status
+	h.server.SetServingStatus(service, status)
+}
+
+// shutdown sets NOT_SERVING to all services: the statuses aren't changed after the shutdown
+func (h *healthChecker) shutdown() {
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.server.Shutdown()
+	h.logger.Info("health status: shutdown")
+}
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dialogs/dialog-push-service/pkg/worker"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestHealthChecker(t *testing.T) {
+
+	w1 := &healthWorker{}
+	w2 := &healthWorker{}
+
+	workers := map[string]worker.IWorker{"p-1": w1, "p-2": w2}
+	h := newHealthChecker(func() map[string]worker.IWorker { return workers }, zap.NewNop())
+
+	requireStatus := func(service string, expected healthpb.HealthCheckResponse_ServingStatus) {
+		t.Helper()
+
+		res, err := h.server.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+		require.NoError(t, err)
+		require.Equal(t, expected, res.Status, service)
+	}
+
+	for _, service := range []string{"", pushingServiceName, "p-1", "p-2"} {
+		requireStatus(service, healthpb.HealthCheckResponse_SERVING)
+	}
+
+	// the project is unavailable
+	w1.err = worker.ErrCircuitOpen
+	h.check()
+
+	requireStatus("", healthpb.HealthCheckResponse_SERVING)
+	requireStatus(pushingServiceName, healthpb.HealthCheckResponse_SERVING)
+	requireStatus("p-1", healthpb.HealthCheckResponse_NOT_SERVING)
+	requireStatus("p-2", healthpb.HealthCheckResponse_SERVING)
+
+	// all projects are unavailable
+	w2.err = worker.ErrCircuitOpen
+	h.check()
+
+	for _, service := range []string{"", pushingServiceName, "p-1", "p-2"} {
+		requireStatus(service, healthpb.HealthCheckResponse_NOT_SERVING)
+	}
+
+	// the providers are available again
+	w1.err = nil
+	w2.err = nil
+	h.check()
+
+	for _, service := range []string{"", pushingServiceName, "p-1", "p-2"} {
+		requireStatus(service, healthpb.HealthCheckResponse_SERVING)
+	}
+
+	// the project is removed by the reload
+	workers = map[string]worker.IWorker{"p-1": w1}
+	h.check()
+
+	requireStatus("", healthpb.HealthCheckResponse_SERVING)
+	requireStatus("p-1", healthpb.HealthCheckResponse_SERVING)
+	requireStatus("p-2", healthpb.HealthCheckResponse_SERVICE_UNKNOWN)
+}
+
+// healthWorker is a worker with the scripted health
+type healthWorker struct {
+	worker.IWorker
+	err error
+}
+
+func (w *healthWorker) Health() error {
+	return w.err
+}
@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// anonymousClient is the identity of the clients without a certificate
+const anonymousClient = "anonymous"
+
+type clientIdentityKey struct{}
+
+// withClientIdentity attaches the identity of the client to the context
+func withClientIdentity(ctx context.Context, identity string) context.Context {
+	return context.WithValue(ctx, clientIdentityKey{}, identity)
+}
+
+// clientIdentity returns the identity of the client: the common name of the verified
+// client certificate or anonymousClient
+func clientIdentity(ctx context.Context) string {
+
+	if identity, ok := ctx.Value(clientIdentityKey{}).(string); ok && identity != "" {
+		return identity
+	}
+
+	return anonymousClient
+}
+
+// peerIdentity returns the identity of the verified client certificate
+func peerIdentity(ctx context.Context) string {
+
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return ""
+	}
+
+	cert := tlsInfo.State.VerifiedChains[0][0]
+	switch {
+	case cert.Subject.CommonName != "":
+		return cert.Subject.CommonName
+	case len(cert.DNSNames) > 0:
+		return cert.DNSNames[0]
+	case len(cert.URIs) > 0:
+		return cert.URIs[0].String()
+	}
+
+	return ""
+}
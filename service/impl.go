@@ -2,13 +2,16 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"sync"
+	"time"
 
 	"github.com/dialogs/dialog-push-service/pkg/api"
 	"github.com/dialogs/dialog-push-service/pkg/conversion"
 	"github.com/dialogs/dialog-push-service/pkg/metric"
+	"github.com/dialogs/dialog-push-service/pkg/retry"
 	"github.com/dialogs/dialog-push-service/pkg/worker"
 	"github.com/dialogs/dialog-push-service/pkg/worker/ans"
 	"github.com/dialogs/dialog-push-service/pkg/worker/fcm"
@@ -24,9 +27,17 @@ var (
 )
 
 type implGRPC struct {
-	metric  *metric.Service
-	workers map[string]worker.IWorker
-	logger  *zap.Logger
+	metric *metric.Service
+	// workers are replaced by the reload: the map isn't changed after it's set
+	workersMu sync.RWMutex
+	workers   map[string]worker.IWorker
+	logger    *zap.Logger
+	// streamMaxInFlight is the limit of the pushes in flight per stream
+	streamMaxInFlight int
+	// retry is the queue of failed notifications. Nil if the queue is disabled
+	retry *retry.Queue
+	// drainer stops the pushes on shutdown
+	drainer *drainer
 }
 
 func newImplGRPC(cfg *Config, logger *zap.Logger) (*implGRPC, error) {
@@ -38,10 +49,27 @@ func newImplGRPC(cfg *Config, logger *zap.Logger) (*implGRPC, error) {
 		return nil, err
 	}
 
+	var retryQueue *retry.Queue
+	if cfg.Retry != nil && cfg.Retry.Enabled() {
+		retryQueue, err = retry.Open(cfg.Retry, logger, svcMetric)
+		if err != nil {
+			closeWorkers(workersList(workers), svcMetric)
+			return nil, err
+		}
+	}
+
+	streamMaxInFlight := cfg.StreamMaxInFlight
+	if streamMaxInFlight <= 0 {
+		streamMaxInFlight = DefaultStreamMaxInFlight
+	}
+
 	return &implGRPC{
-		metric:  svcMetric,
-		workers: workers,
-		logger:  logger,
+		metric:            svcMetric,
+		workers:           workers,
+		logger:            logger,
+		streamMaxInFlight: streamMaxInFlight,
+		retry:             retryQueue,
+		drainer:           newDrainer(),
 	}, nil
 }
 
@@ -56,57 +84,126 @@ func (i *implGRPC) Ping(context.Context, *api.PingRequest) (*api.PongResponse, e
 	return &api.PongResponse{}, nil
 }
 
+// PushStream acknowledges every push exactly once: the response has the correlation ID
+// of the push and the results of all projects or the error of the push.
+// The pushes are sent concurrently, the responses are written by one goroutine.
+// No more than `stream-max-in-flight` pushes are sent at once: the stream isn't read
+// until the acknowledgement of a push is written
 func (i *implGRPC) PushStream(stream api.Pushing_PushStreamServer) error {
 
-	l := i.logger.With(zap.String("method", "push stream"))
+	l := i.logger.With(
+		zap.String("method", "push stream"),
+		zap.String("client", clientIdentity(stream.Context())))
 	defer func() { l.Info("close stream") }()
 
+	// the pushes are canceled if the stream is closed
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	inFlight := make(chan struct{}, i.streamMaxInFlight)
+	chRes := make(chan *api.Response, i.streamMaxInFlight)
+	chWriterErr := make(chan error, 1)
+
+	go func() {
+		chWriterErr <- writeResponses(stream, chRes, inFlight, cancel, l)
+	}()
+
+	wg := sync.WaitGroup{}
+	wait := func() error {
+		wg.Wait()
+		close(chRes)
+		return <-chWriterErr
+	}
+
 	for {
+		select {
+		case inFlight <- struct{}{}:
+		case <-ctx.Done():
+		}
+
+		if ctx.Err() != nil {
+			// the stream is closed or the responses can't be written
+			if err := wait(); err != nil {
+				return err
+			}
+			return ctx.Err()
+		}
+
 		push, err := stream.Recv()
-		if err == io.EOF {
-			return nil
-		} else if err != nil {
-			return err
+		if err != nil {
+			<-inFlight
+
+			if err != io.EOF {
+				// the stream is broken: the acknowledgements can't be written
+				cancel()
+				wait()
+				return err
+			}
+
+			// acknowledge the received pushes before the end of the stream
+			return wait()
 		}
 
+		wg.Add(1)
 		go func(taskLogger *zap.Logger, task *api.Push) {
-			chOut, err := i.sendPush(stream.Context(), task, l)
+			defer wg.Done()
+
+			// the push to a foreign project is rejected by the response, the stream isn't closed
+			var res *api.Response
+			err := authorizePush(ctx, task)
+			if err == nil {
+				res, err = i.singlePush(ctx, task, l)
+			}
+
 			if err != nil {
 				taskLogger.Error("failed to send push", zap.Error(err))
-				return
+				res = newErrorResponse(task, err)
 			}
 
-			for pushRes := range chOut {
-				if len(pushRes.InvalidationDevices) == 0 {
-					taskLogger.Info("empty invalidation devices list", zap.String("project id", pushRes.ProjectID))
-					continue
-				}
+			// the channel has a place for every push in flight
+			chRes <- res
+		}(l.With(zap.String("correlation id", push.CorrelationId)), push)
+	}
+}
 
-				res := &api.Response{
-					ProjectInvalidations: map[string]*api.DeviceIdList{
-						pushRes.ProjectID: &api.DeviceIdList{
-							DeviceIds: pushRes.InvalidationDevices,
-						},
-					},
-				}
+// writeResponses writes the responses to the stream until the channel is closed. The place
+// of the push in flight is released after the response is written. If the stream is broken,
+// the pushes are canceled and the rest of the responses are dropped
+func writeResponses(stream api.Pushing_PushStreamServer, chRes <-chan *api.Response, inFlight <-chan struct{}, cancel func(), l *zap.Logger) error {
 
-				taskLogger.Info("send: start")
-				if err := stream.Send(res); err != nil {
-					l.Error("send: error", zap.Error(err))
-				} else {
-					taskLogger.Info("send: end")
-				}
+	var retval error
+
+	for res := range chRes {
+		if retval == nil {
+			taskLogger := l.With(zap.String("correlation id", res.CorrelationId))
+
+			taskLogger.Info("send: start")
+			if err := stream.Send(res); err != nil {
+				taskLogger.Error("send: error", zap.Error(err))
+				retval = err
+				cancel()
+			} else {
+				taskLogger.Info("send: end")
 			}
+		}
 
-		}(l.With(zap.String("correlation id", push.CorrelationId)), push)
+		<-inFlight
 	}
+
+	return retval
 }
 
 func (i *implGRPC) SinglePush(ctx context.Context, push *api.Push) (*api.Response, error) {
 
-	l := i.logger.With(zap.String("method", "single push"))
+	l := i.logger.With(
+		zap.String("method", "single push"),
+		zap.String("client", clientIdentity(ctx)))
 
-	cleanPush(push)
+	return i.singlePush(ctx, push, l)
+}
+
+// singlePush sends the push and aggregates the results of the projects
+func (i *implGRPC) singlePush(ctx context.Context, push *api.Push, l *zap.Logger) (*api.Response, error) {
 
 	chRes, err := i.sendPush(ctx, push, l)
 	if err != nil {
@@ -115,6 +212,8 @@ func (i *implGRPC) SinglePush(ctx context.Context, push *api.Push) (*api.Respons
 
 	res := &api.Response{
 		ProjectInvalidations: make(map[string]*api.DeviceIdList, len(push.Destinations)),
+		Results:              make(map[string]*api.DeviceResultList, len(push.Destinations)),
+		CorrelationId:        push.CorrelationId,
 	}
 
 	for pushRes := range chRes {
@@ -128,6 +227,35 @@ func (i *implGRPC) SinglePush(ctx context.Context, push *api.Push) (*api.Respons
 		}
 
 		res.ProjectInvalidations[pushRes.ProjectID] = target
+
+		results, ok := res.Results[pushRes.ProjectID]
+		if ok {
+			results.Results = append(results.Results, pushRes.Results...)
+		} else {
+			results = &api.DeviceResultList{
+				Results: pushRes.Results,
+			}
+		}
+
+		res.Results[pushRes.ProjectID] = results
+
+		if len(pushRes.Replacements) > 0 {
+			if res.ProjectReplacements == nil {
+				res.ProjectReplacements = make(map[string]*api.TokenReplacements)
+			}
+
+			replacements, ok := res.ProjectReplacements[pushRes.ProjectID]
+			if !ok {
+				replacements = &api.TokenReplacements{
+					Tokens: make(map[string]string, len(pushRes.Replacements)),
+				}
+				res.ProjectReplacements[pushRes.ProjectID] = replacements
+			}
+
+			for oldToken, newToken := range pushRes.Replacements {
+				replacements.Tokens[oldToken] = newToken
+			}
+		}
 	}
 
 	return res, nil
@@ -138,7 +266,7 @@ func (i *implGRPC) sendPush(ctx context.Context, push *api.Push, l *zap.Logger)
 	l = l.With(zap.String("id", push.CorrelationId))
 
 	addrInfo := i.getAddrInfo(ctx)
-	peerMetric, err := i.metric.GetPeerMetrics(addrInfo)
+	peerMetric, err := i.metric.GetPeerMetrics(addrInfo, clientIdentity(ctx))
 	if err != nil {
 		l.Error("get peer metric", zap.Error(err))
 		return nil, err
@@ -148,83 +276,199 @@ func (i *implGRPC) sendPush(ctx context.Context, push *api.Push, l *zap.Logger)
 
 	peerMetric.Inc()
 
+	// the push is canceled by the client or by the shutdown
+	ctx, cancel, ok := i.drainer.begin(ctx)
+	if !ok {
+		return nil, errShuttingDown
+	}
+
+	// the workers of all projects admit the push before any project is sent:
+	// the push isn't sent to any project if a worker queue is full
+	tasks := make([]*sendPushTask, 0, len(push.Destinations))
+	for projectID, deviceList := range push.Destinations {
+		task, err := i.newSendPushTask(ctx, push, projectID, deviceList.GetDeviceIds(), l)
+		if err != nil {
+			for _, task := range tasks {
+				task.release()
+			}
+			cancel()
+			return nil, err
+		}
+
+		tasks = append(tasks, task)
+	}
+
+	for _, task := range tasks {
+		task.send(ctx)
+	}
+
 	chOut := make(chan *sendPushResult)
 
 	go func() {
+		defer cancel()
 		defer func() { close(chOut) }()
 
-		if len(push.Destinations) == 0 {
-			return
+		wg := sync.WaitGroup{}
+
+		for _, task := range tasks {
+			wg.Add(1)
+			go func(task *sendPushTask) {
+				defer wg.Done()
+
+				chOut <- i.getSendPushResult(task, push)
+			}(task)
 		}
 
-		wg := sync.WaitGroup{}
+		wg.Wait()
+	}()
 
-		for projectID, deviceList := range push.Destinations {
-			projectLogger := l.With(zap.String("project id", projectID))
+	return chOut, nil
+}
 
-			w, err := i.getWorker(projectID)
-			if err != nil {
-				projectLogger.Error("get worker", zap.Error(err))
+// newSendPushTask converts the push to the request of the project worker and
+// admits the request to the worker queue. The request is sent by sendPushTask.send
+func (i *implGRPC) newSendPushTask(ctx context.Context, push *api.Push, projectID string, devices []string, l *zap.Logger) (*sendPushTask, error) {
 
-				chOut <- newSendPushResult(projectID)
-				continue
-			}
+	priority := pushPriority(push)
 
-			wg.Add(1)
-			go func(projectWorker worker.IWorker, devices []string) {
-				defer wg.Done()
+	task := &sendPushTask{
+		projectID: projectID,
+		devices:   devices,
+		priority:  priority,
+		logger:    l.With(zap.String("project id", projectID), zap.Stringer("priority", priority)),
+	}
 
-				req := &worker.Request{
-					Devices:       devices,
-					CorrelationID: push.CorrelationId,
-				}
+	w, err := i.getWorker(projectID)
+	if err != nil {
+		task.logger.Error("get worker", zap.Error(err))
+		return task, nil
+	}
 
-				conversationConfig := projectWorker.ConversionConfig()
-
-				switch w.Kind() {
-				case worker.KindApns:
-					req.Payload, err = conversion.RequestPbToAns(push.Body, w.SupportsVoIP(), conversationConfig.AllowAlerts, &conversationConfig.Topic, &conversationConfig.Sound)
-				case worker.KindFcm:
-					req.Payload, err = conversion.RequestPbToFcm(push.Body, conversationConfig.AllowAlerts)
-				case worker.KindGcm:
-					req.Payload, err = conversion.RequestPbToGcm(push.Body, conversationConfig.AllowAlerts)
-				default:
-					err = errUnknownConversationRules
-				}
+	task.worker = w
 
-				if err != nil {
-					projectLogger.Error("conversation", zap.Error(err))
-				}
+	req := &worker.Request{
+		Devices:       devices,
+		CorrelationID: push.CorrelationId,
+		Priority:      priority,
+	}
 
-				pushRes := newSendPushResult(projectWorker.ProjectID())
+	conversationConfig := w.ConversionConfig()
 
-				if err == nil && !req.Payload.ShouldIgnore() {
-					for res := range projectWorker.Send(ctx, req) {
+	switch w.Kind() {
+	case worker.KindApns:
+		topic := conversationConfig.Topic
+		if push.Body.GetVoipPush() != nil && conversationConfig.VoIPTopic != "" {
+			topic = conversationConfig.VoIPTopic
+		}
 
-						if res.Error != nil {
-							workerErr, ok := res.Error.(*worker.ResponseError)
+		req.Payload, err = conversion.RequestPbToAns(push.Body, w.SupportsVoIP(), conversationConfig.AllowAlerts, &topic, &conversationConfig.Sound)
+	case worker.KindFcm:
+		req.Payload, err = conversion.RequestPbToFcm(push.Body, conversationConfig.AllowAlerts)
+	case worker.KindGcm:
+		req.Payload, err = conversion.RequestPbToGcm(push.Body, conversationConfig.AllowAlerts)
+	default:
+		err = errUnknownConversationRules
+	}
 
-							if ok && (workerErr.Code == worker.ErrorCodeBadDeviceToken) {
-								pushRes.InvalidationDevices = append(pushRes.InvalidationDevices, res.DeviceToken)
-							}
-						}
-					}
-				}
+	if err != nil {
+		task.logger.Error("conversation", zap.Error(err))
+		task.conversionErr = err
+		return task, nil
+	}
 
-				chOut <- pushRes
+	if req.Payload.ShouldIgnore() {
+		return task, nil
+	}
 
-			}(w, deviceList.GetDeviceIds())
+	if i.retry != nil {
+		task.retryPayload, err = json.Marshal(req.Payload)
+		if err != nil {
+			task.logger.Error("encode payload for retry", zap.Error(err))
 		}
+	}
 
-		wg.Wait()
-	}()
+	task.admission, err = w.Admit(ctx, req)
+	if err != nil {
+		task.logger.Error("send", zap.Error(err))
+		return nil, admissionError(projectID, err)
+	}
 
-	return chOut, nil
+	return task, nil
+}
+
+// getSendPushResult reads the worker responses of the task
+func (i *implGRPC) getSendPushResult(task *sendPushTask, push *api.Push) *sendPushResult {
+
+	if task.worker == nil {
+		// the push isn't sent to the unknown project: the failure is reported for every device
+		pushRes := newSendPushResult(task.projectID)
+		pushRes.rejectAll(task.devices, errInvalidProjectID)
+
+		return pushRes
+	}
+
+	pushRes := newSendPushResult(task.worker.ProjectID())
+
+	if task.conversionErr != nil {
+		// the payload can't be sent to any device of the project
+		pushRes.rejectAll(task.devices, task.conversionErr)
+
+		return pushRes
+	}
+
+	if task.responses == nil {
+		// the payload is ignored
+		return pushRes
+	}
+
+	responses := make([]*worker.Response, 0, len(task.devices))
+	for res := range task.responses {
+		responses = append(responses, res)
+	}
+
+	aborted := i.drainer.aborted()
+	if aborted {
+		// the devices without the responses aren't sent: the push is canceled by the shutdown
+		responses = appendCanceledResponses(responses, task.worker.ProjectID(), task.devices)
+	}
+
+	// the worker sends to the devices concurrently
+	sortResponses(responses, task.devices)
+
+	for _, res := range responses {
+		deviceRes := newDeviceResult(res)
+
+		retryable := worker.IsRetryable(res.Error) || (aborted && isCanceled(res.Error))
+		if task.retryPayload != nil && retryable {
+			ttl := time.Duration(push.GetBody().GetTimeToLive()) * time.Second
+			if err := i.spill(res, push.CorrelationId, task.priority, task.retryPayload, ttl); err != nil {
+				task.logger.Error("failed to store for retry", zap.Error(err))
+			} else {
+				deviceRes.Status = api.StatusRetryQueued
+			}
+		}
+
+		pushRes.Results = append(pushRes.Results, deviceRes)
+
+		if res.CanonicalToken != "" {
+			pushRes.Replacements[res.DeviceToken] = res.CanonicalToken
+		}
+
+		if res.Error != nil {
+			workerErr, ok := res.Error.(*worker.ResponseError)
+
+			if ok && (workerErr.Code == worker.ErrorCodeBadDeviceToken || workerErr.Code == worker.ErrorCodeUnregistered) {
+				pushRes.InvalidationDevices = append(pushRes.InvalidationDevices, res.DeviceToken)
+			}
+		}
+	}
+
+	return pushRes
 }
 
 func (i *implGRPC) getWorker(projectID string) (worker.IWorker, error) {
 
-	w, ok := i.workers[projectID]
+	w, ok := i.currentWorkers()[projectID]
 	if !ok {
 		return nil, errInvalidProjectID
 	}
@@ -232,6 +476,32 @@ func (i *implGRPC) getWorker(projectID string) (worker.IWorker, error) {
 	return w, nil
 }
 
+// currentWorkers returns the workers of the projects. The map must not be changed
+func (i *implGRPC) currentWorkers() map[string]worker.IWorker {
+
+	i.workersMu.RLock()
+	defer i.workersMu.RUnlock()
+
+	return i.workers
+}
+
+// setWorkers replaces the workers of the projects
+func (i *implGRPC) setWorkers(workers map[string]worker.IWorker) {
+
+	i.workersMu.Lock()
+	defer i.workersMu.Unlock()
+
+	i.workers = workers
+}
+
+// close closes the connections of the workers
+func (i *implGRPC) close() {
+
+	for _, w := range i.currentWorkers() {
+		w.Close()
+	}
+}
+
 func (i *implGRPC) getAddrInfo(ctx context.Context) string {
 	peer, peerOk := peer.FromContext(ctx)
 	if peerOk {
@@ -246,37 +516,7 @@ func getWorkers(cfg *Config, logger *zap.Logger, svcMetric *metric.Service) (map
 	m := make(map[string]worker.IWorker)
 
 	err := cfg.WalkConfigs(func(c interface{}) error {
-		var (
-			w   worker.IWorker
-			err error
-		)
-
-		switch c.(type) {
-		case *ans.Config:
-			wConf := c.(*ans.Config)
-			w, err = ans.New(wConf, logger, svcMetric)
-			if err != nil {
-				err = errors.Wrap(err, "project ID: "+wConf.ProjectID)
-			}
-
-		case *gcm.Config:
-			wConf := c.(*gcm.Config)
-			w, err = gcm.New(wConf, logger, svcMetric)
-			if err != nil {
-				err = errors.Wrap(err, "project ID: "+wConf.ProjectID)
-			}
-
-		case *fcm.Config:
-			wConf := c.(*fcm.Config)
-			w, err = fcm.New(wConf, logger, svcMetric)
-			if err != nil {
-				err = errors.Wrap(err, "project ID: "+wConf.ProjectID)
-			}
-
-		default:
-			err = fmt.Errorf("unknown config type: %T", c)
-		}
-
+		w, err := newWorker(c, logger, svcMetric)
 		if err != nil {
 			return err
 		}
@@ -284,6 +524,7 @@ func getWorkers(cfg *Config, logger *zap.Logger, svcMetric *metric.Service) (map
 		projectID := w.ProjectID()
 		_, ok := m[projectID]
 		if ok {
+			closeWorkers([]worker.IWorker{w}, svcMetric)
 			return errors.New("not unique project id of a worker:" + projectID)
 		}
 
@@ -292,8 +533,69 @@ func getWorkers(cfg *Config, logger *zap.Logger, svcMetric *metric.Service) (map
 	})
 
 	if err != nil {
+		closeWorkers(workersList(m), svcMetric)
 		return nil, err
 	}
 
 	return m, nil
 }
+
+// closeWorkers closes the workers and deletes the gauges of the projects: the workers aren't used
+func closeWorkers(workers []worker.IWorker, svcMetric *metric.Service) {
+
+	for _, w := range workers {
+		w.Close()
+		svcMetric.DeleteProviderMetrics(w.Kind().String(), w.ProjectID())
+	}
+}
+
+func workersList(m map[string]worker.IWorker) []worker.IWorker {
+
+	retval := make([]worker.IWorker, 0, len(m))
+	for _, w := range m {
+		retval = append(retval, w)
+	}
+
+	return retval
+}
+
+// newWorker creates the worker of the project config
+func newWorker(c interface{}, logger *zap.Logger, svcMetric *metric.Service) (worker.IWorker, error) {
+
+	var (
+		w   worker.IWorker
+		err error
+	)
+
+	switch c.(type) {
+	case *ans.Config:
+		wConf := c.(*ans.Config)
+		w, err = ans.New(wConf, logger, svcMetric)
+		if err != nil {
+			err = errors.Wrap(err, "project ID: "+wConf.ProjectID)
+		}
+
+	case *gcm.Config:
+		wConf := c.(*gcm.Config)
+		w, err = gcm.New(wConf, logger, svcMetric)
+		if err != nil {
+			err = errors.Wrap(err, "project ID: "+wConf.ProjectID)
+		}
+
+	case *fcm.Config:
+		wConf := c.(*fcm.Config)
+		w, err = fcm.New(wConf, logger, svcMetric)
+		if err != nil {
+			err = errors.Wrap(err, "project ID: "+wConf.ProjectID)
+		}
+
+	default:
+		err = fmt.Errorf("unknown config type: %T", c)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
@@ -0,0 +1,274 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/dialogs/dialog-push-service/pkg/api"
+	"github.com/dialogs/dialog-push-service/pkg/conversion"
+	"github.com/dialogs/dialog-push-service/pkg/metric"
+	"github.com/dialogs/dialog-push-service/pkg/provider"
+	"github.com/dialogs/dialog-push-service/pkg/worker"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPushStreamFlowControl(t *testing.T) {
+
+	impl := &implGRPC{
+		metric:            metric.New(),
+		workers:           map[string]worker.IWorker{},
+		logger:            zap.NewNop(),
+		streamMaxInFlight: 2,
+		drainer:           newDrainer(),
+	}
+
+	stream := newFakePushStream(10)
+
+	chErr := make(chan error)
+	go func() { chErr <- impl.PushStream(stream) }()
+
+	// the responses aren't written: the stream isn't read after 2 pushes
+	time.Sleep(100 * time.Millisecond)
+	require.Equal(t, 2, stream.countRecv())
+
+	close(stream.release)
+	require.NoError(t, <-chErr)
+
+	// 10 pushes and the end of the stream
+	require.Equal(t, 11, stream.countRecv())
+
+	correlationIDs := make([]string, 0)
+	for _, res := range stream.responses {
+		correlationIDs = append(correlationIDs, res.CorrelationId)
+	}
+	require.ElementsMatch(t,
+		[]string{"push-0", "push-1", "push-2", "push-3", "push-4", "push-5", "push-6", "push-7", "push-8", "push-9"},
+		correlationIDs)
+}
+
+func TestPushStreamSendError(t *testing.T) {
+
+	impl := &implGRPC{
+		metric:            metric.New(),
+		workers:           map[string]worker.IWorker{},
+		logger:            zap.NewNop(),
+		streamMaxInFlight: 2,
+		drainer:           newDrainer(),
+	}
+
+	stream := newFakePushStream(10)
+	stream.sendErr = errors.New("transport is closing")
+	close(stream.release)
+
+	require.Equal(t, stream.sendErr, impl.PushStream(stream))
+	require.Empty(t, stream.responses)
+	require.LessOrEqual(t, stream.countRecv(), 3)
+}
+
+func TestPushStreamForeignProject(t *testing.T) {
+
+	impl := &implGRPC{
+		metric:            metric.New(),
+		workers:           map[string]worker.IWorker{},
+		logger:            zap.NewNop(),
+		streamMaxInFlight: 2,
+		drainer:           newDrainer(),
+	}
+
+	stream := newFakePushStream(3)
+	stream.pushes[1].Destinations = map[string]*api.DeviceIdList{"p-foreign": {DeviceIds: []string{"token"}}}
+	stream.ctx = context.WithValue(context.Background(), authClientKey{}, &authClient{
+		name:     "messenger",
+		projects: map[string]struct{}{"p-unknown": {}},
+	})
+	close(stream.release)
+
+	// the push is rejected by the response, the rest of the pushes are sent
+	require.NoError(t, impl.PushStream(stream))
+	require.Equal(t, 4, stream.countRecv())
+	require.Len(t, stream.responses, 3)
+
+	for _, res := range stream.responses {
+		if res.CorrelationId == "push-1" {
+			require.Equal(t, int32(codes.PermissionDenied), res.Error.GetCode())
+			require.Equal(t, "project ID: p-foreign: access denied", res.Error.GetMessage())
+		} else {
+			require.NotEqual(t, int32(codes.PermissionDenied), res.Error.GetCode())
+		}
+	}
+}
+
+func TestSendPushQueueFull(t *testing.T) {
+
+	sent := int32(0)
+	newWorker := func(projectID string) *gcmWorker {
+		t.Helper()
+
+		w, err := worker.New(
+			&worker.Config{
+				Config:       &conversion.Config{},
+				ProjectID:    projectID,
+				CountThreads: 1,
+				QueueSize:    1,
+				QueueTimeout: 10 * time.Millisecond,
+			},
+			worker.KindGcm,
+			false,
+			zap.NewNop(),
+			metric.New(),
+			func(context.Context, provider.IRequest) (*worker.Result, error) {
+				atomic.AddInt32(&sent, 1)
+				return &worker.Result{}, nil
+			})
+		require.NoError(t, err)
+
+		return &gcmWorker{Worker: w}
+	}
+
+	p1, p2 := newWorker("p-1"), newWorker("p-2")
+
+	impl := &implGRPC{
+		metric:  metric.New(),
+		workers: map[string]worker.IWorker{"p-1": p1, "p-2": p2},
+		logger:  zap.NewNop(),
+		drainer: newDrainer(),
+	}
+
+	push := &api.Push{
+		Destinations: map[string]*api.DeviceIdList{
+			"p-1": {DeviceIds: []string{"token1"}},
+			"p-2": {DeviceIds: []string{"token2"}},
+		},
+		Body: &api.PushBody{
+			Body: &api.PushBody_EncryptedPush{
+				EncryptedPush: &api.EncryptedPush{EncryptedData: []byte("push body")},
+			},
+		},
+	}
+
+	// the queue of p-2 is full
+	a, err := p2.Admit(context.Background(), &worker.Request{Priority: pushPriority(push)})
+	require.NoError(t, err)
+
+	_, err = impl.sendPush(context.Background(), push, zap.NewNop())
+	require.Equal(t, codes.ResourceExhausted, status.Code(err), err)
+
+	// the push isn't sent to any project and the places in the queues are released
+	a.Release()
+	require.Equal(t, int32(0), atomic.LoadInt32(&sent))
+	require.Equal(t, 0, p1.InFlight())
+	require.Equal(t, 0, p2.InFlight())
+}
+
+// gcmWorker is the base worker with the provider methods of IWorker
+type gcmWorker struct {
+	*worker.Worker
+}
+
+func (w *gcmWorker) SupportsVoIP() bool {
+	return false
+}
+
+func (w *gcmWorker) NewRequest() provider.IRequest {
+	return nil
+}
+
+func (w *gcmWorker) Close() {}
+
+// fakePushStream returns the pushes and the end of the stream. Send waits for the release
+// and fails if the stream is written concurrently
+type fakePushStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	mu        sync.Mutex
+	pushes    []*api.Push
+	recv      int
+	sending   bool
+	sendErr   error
+	release   chan struct{}
+	responses []*api.Response
+}
+
+func newFakePushStream(countPushes int) *fakePushStream {
+
+	pushes := make([]*api.Push, countPushes)
+	for i := range pushes {
+		pushes[i] = &api.Push{
+			Destinations:  map[string]*api.DeviceIdList{"p-unknown": {DeviceIds: []string{"token"}}},
+			CorrelationId: "push-" + strconv.Itoa(i),
+		}
+	}
+
+	return &fakePushStream{
+		pushes:  pushes,
+		release: make(chan struct{}),
+	}
+}
+
+func (s *fakePushStream) Context() context.Context {
+
+	if s.ctx != nil {
+		return s.ctx
+	}
+
+	return context.Background()
+}
+
+func (s *fakePushStream) Recv() (*api.Push, error) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.recv++
+
+	if len(s.pushes) == 0 {
+		return nil, io.EOF
+	}
+
+	push := s.pushes[0]
+	s.pushes = s.pushes[1:]
+
+	return push, nil
+}
+
+func (s *fakePushStream) Send(res *api.Response) error {
+
+	s.mu.Lock()
+	if s.sending {
+		s.mu.Unlock()
+		return errors.New("concurrent send")
+	}
+	s.sending = true
+	s.mu.Unlock()
+
+	<-s.release
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sending = false
+	if s.sendErr != nil {
+		return s.sendErr
+	}
+
+	s.responses = append(s.responses, res)
+	return nil
+}
+
+func (s *fakePushStream) countRecv() int {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.recv
+}
@@ -0,0 +1,305 @@
+package service
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/dialogs/dialog-push-service/pkg/api"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	projectsPath = "/projects"
+	// projectIDParam is the query parameter of `DELETE /projects`
+	projectIDParam = "project-id"
+	reloadPath     = "/reload"
+	// reloadMethod is the method of `POST /reload` for the authorization:
+	// the reload is available to the clients of ProjectAdmin only
+	reloadMethod = "/main.ProjectAdmin/Reload"
+)
+
+var errProjectStoreDisabled = status.Error(codes.FailedPrecondition, "project store is disabled: `projects-dir` isn't set")
+
+// projectAdmin is the ProjectAdmin service and the `/projects` endpoint of the admin port.
+// The projects are applied by the reloader: the workers are replaced as on reload.
+// The HTTP requests are authorized as the gRPC requests: the admin client is required
+type projectAdmin struct {
+	reloader *reloader
+	auth     *authorizer
+	logger   *zap.Logger
+}
+
+func newProjectAdmin(r *reloader, auth *authorizer, logger *zap.Logger) *projectAdmin {
+	return &projectAdmin{
+		reloader: r,
+		auth:     auth,
+		logger:   logger.With(zap.String("component", "project admin")),
+	}
+}
+
+func (a *projectAdmin) PutProject(_ context.Context, spec *api.ProjectSpec) (*api.ProjectInfo, error) {
+	return a.reloader.putProject(spec)
+}
+
+func (a *projectAdmin) ListProjects(context.Context, *api.ListProjectsRequest) (*api.ListProjectsResponse, error) {
+	return &api.ListProjectsResponse{
+		Projects: a.reloader.listProjects(),
+	}, nil
+}
+
+func (a *projectAdmin) DeleteProject(_ context.Context, req *api.DeleteProjectRequest) (*api.DeleteProjectResponse, error) {
+
+	if err := a.reloader.deleteProject(req.ProjectId); err != nil {
+		return nil, err
+	}
+
+	return &api.DeleteProjectResponse{}, nil
+}
+
+// ServeHTTP maps `GET /projects` to ListProjects, `POST /projects` to PutProject
+// and `DELETE /projects?project-id=<ID>` to DeleteProject
+func (a *projectAdmin) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+
+	var (
+		method  string
+		req     interface{}
+		handler grpc.UnaryHandler
+	)
+
+	switch r.Method {
+	case http.MethodGet:
+		method, req = "/main.ProjectAdmin/ListProjects", &api.ListProjectsRequest{}
+		handler = func(ctx context.Context, req interface{}) (interface{}, error) {
+			return a.ListProjects(ctx, req.(*api.ListProjectsRequest))
+		}
+
+	case http.MethodPost:
+		spec := &api.ProjectSpec{}
+		unmarshaler := jsonpb.Unmarshaler{}
+		if err := unmarshaler.Unmarshal(io.LimitReader(r.Body, restMaxBodySize), spec); err != nil {
+			writeError(w, status.Error(codes.InvalidArgument, "invalid project: "+err.Error()), a.logger)
+			return
+		}
+
+		method, req = "/main.ProjectAdmin/PutProject", spec
+		handler = func(ctx context.Context, req interface{}) (interface{}, error) {
+			return a.PutProject(ctx, req.(*api.ProjectSpec))
+		}
+
+	case http.MethodDelete:
+		method, req = "/main.ProjectAdmin/DeleteProject", &api.DeleteProjectRequest{
+			ProjectId: r.URL.Query().Get(projectIDParam),
+		}
+		handler = func(ctx context.Context, req interface{}) (interface{}, error) {
+			return a.DeleteProject(ctx, req.(*api.DeleteProjectRequest))
+		}
+
+	default:
+		writeMethodNotAllowed(w, http.MethodGet+", "+http.MethodPost+", "+http.MethodDelete, a.logger)
+		return
+	}
+
+	res, err := interceptHTTP(a.auth, r, method, req, handler)
+	if err != nil {
+		writeError(w, err, a.logger)
+		return
+	}
+
+	writeMessage(w, res.(proto.Message), a.logger)
+}
+
+// ServeReload passes `/reload` to the reloader. `GET /reload` returns the result of the last
+// reload to any client, `POST /reload` is authorized as the request of ProjectAdmin
+func (a *projectAdmin) ServeReload(w http.ResponseWriter, r *http.Request) {
+
+	if r.Method == http.MethodPost {
+		authorized := func(context.Context, interface{}) (interface{}, error) {
+			return nil, nil
+		}
+
+		if _, err := interceptHTTP(a.auth, r, reloadMethod, nil, authorized); err != nil {
+			writeError(w, err, a.logger)
+			return
+		}
+	}
+
+	a.reloader.ServeHTTP(w, r)
+}
+
+// putProject creates or replaces the stored project. The project is saved
+// after the worker is created and is applied after the project is saved
+func (r *reloader) putProject(spec *api.ProjectSpec) (*api.ProjectInfo, error) {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.store == nil {
+		return nil, errProjectStoreDisabled
+	}
+
+	// the ID is a file name of the store: the invalid ID isn't passed to the store
+	if err := checkProjectID(spec.ProjectId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if r.inConfig(spec.ProjectId) {
+		return nil, status.Error(codes.FailedPrecondition, "project ID: "+spec.ProjectId+": the project is defined in the config file")
+	}
+
+	c, err := r.store.config(spec)
+	if err != nil {
+		r.discardCredentials(spec.ProjectId)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	stored := r.copyStored()
+	stored[spec.ProjectId] = c
+
+	res := &ReloadResult{
+		Time: time.Now(),
+	}
+
+	update, err := r.prepare(r.cfg, stored, res)
+	if err != nil {
+		r.discardCredentials(spec.ProjectId)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := r.store.save(spec); err != nil {
+		r.discard(update)
+		r.discardCredentials(spec.ProjectId)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	r.commit(update)
+	r.stored = stored
+
+	r.logger.Info("put project",
+		zap.String("project id", spec.ProjectId),
+		zap.Strings("added", res.Added),
+		zap.Strings("updated", res.Updated))
+
+	return r.projectInfo(c, api.SourceAdmin), nil
+}
+
+// discardCredentials removes the credential files of the rejected spec
+func (r *reloader) discardCredentials(projectID string) {
+
+	if err := r.store.discard(projectID); err != nil {
+		r.logger.Error("discard credentials", zap.String("project id", projectID), zap.Error(err))
+	}
+}
+
+// deleteProject removes the stored project
+func (r *reloader) deleteProject(projectID string) error {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.store == nil {
+		return errProjectStoreDisabled
+	}
+
+	if _, ok := r.stored[projectID]; !ok {
+		if r.inConfig(projectID) {
+			return status.Error(codes.FailedPrecondition, "project ID: "+projectID+": the project is defined in the config file")
+		}
+
+		return status.Error(codes.NotFound, "project ID: "+projectID+": not found")
+	}
+
+	stored := r.copyStored()
+	delete(stored, projectID)
+
+	update, err := r.prepare(r.cfg, stored, &ReloadResult{})
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	if err := r.store.remove(projectID); err != nil {
+		r.discard(update)
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	r.commit(update)
+	r.stored = stored
+
+	r.logger.Info("delete project", zap.String("project id", projectID))
+
+	return nil
+}
+
+// listProjects returns the projects of the config file and the stored projects
+func (r *reloader) listProjects() []*api.ProjectInfo {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	retval := make([]*api.ProjectInfo, 0)
+
+	r.cfg.WalkConfigs(func(c interface{}) error {
+		retval = append(retval, r.projectInfo(c, api.SourceConfig))
+		return nil
+	})
+
+	for _, c := range r.stored {
+		retval = append(retval, r.projectInfo(c, api.SourceAdmin))
+	}
+
+	sort.Slice(retval, func(i, j int) bool {
+		return retval[i].ProjectId < retval[j].ProjectId
+	})
+
+	return retval
+}
+
+func (r *reloader) projectInfo(c interface{}, source api.ProjectSource) *api.ProjectInfo {
+
+	base, _ := projectConfig(c)
+
+	info := &api.ProjectInfo{
+		ProjectId: base.ProjectID,
+		Sandbox:   base.Sandbox,
+		NopMode:   base.NopMode,
+		Source:    source,
+	}
+
+	if w, err := r.impl.getWorker(base.ProjectID); err == nil {
+		info.Kind = w.Kind().String()
+		info.Healthy = w.Health() == nil
+	}
+
+	return info
+}
+
+// inConfig returns true if the project is defined in the config file
+func (r *reloader) inConfig(projectID string) bool {
+
+	found := false
+	r.cfg.WalkConfigs(func(c interface{}) error {
+		if base, _ := projectConfig(c); base != nil && base.ProjectID == projectID {
+			found = true
+		}
+		return nil
+	})
+
+	return found
+}
+
+func (r *reloader) copyStored() map[string]interface{} {
+
+	retval := make(map[string]interface{}, len(r.stored)+1)
+	for projectID, c := range r.stored {
+		retval[projectID] = c
+	}
+
+	return retval
+}
@@ -0,0 +1,393 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dialogs/dialog-push-service/pkg/api"
+	"github.com/dialogs/dialog-push-service/pkg/test"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestProjectAdmin(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "projects")
+	require.NoError(t, err)
+	defer func() { require.NoError(t, os.RemoveAll(dir)) }()
+
+	file := filepath.Join(dir, "config.yaml")
+	require.NoError(t, ioutil.WriteFile(file, []byte(`
+google:
+  - project-id: p-1
+    key: server-key
+    nop-mode: true
+`), 0600))
+
+	storeDir := filepath.Join(dir, "store")
+
+	auth, err := newAuthorizer([]*ClientConfig{
+		{Name: "admin", APIKey: "admin-key", Projects: []string{allProjects}},
+		{Name: "messenger", APIKey: "messenger-key", Projects: []string{"p-1"}},
+	})
+	require.NoError(t, err)
+
+	// newAdmin starts the service projects from the config file and the store
+	newAdmin := func() (*projectAdmin, *implGRPC) {
+		t.Helper()
+
+		v := viper.New()
+		v.SetConfigFile(file)
+		require.NoError(t, v.ReadInConfig())
+
+		cfg, err := NewConfig(v)
+		require.NoError(t, err)
+
+		impl, err := newImplGRPC(cfg, zap.NewNop())
+		require.NoError(t, err)
+
+		store, err := openProjectStore(storeDir)
+		require.NoError(t, err)
+
+		r, err := newReloader(v, cfg, impl, store, time.Second, zap.NewNop())
+		require.NoError(t, err)
+
+		return newProjectAdmin(r, auth, zap.NewNop()), impl
+	}
+
+	admin, impl := newAdmin()
+	defer impl.close()
+
+	ctx := context.Background()
+
+	pem1, err := test.NewAppleCertificatePem(test.AppleCertificate{Production: true})
+	require.NoError(t, err)
+
+	pem2, err := test.NewAppleCertificatePem(test.AppleCertificate{Production: true})
+	require.NoError(t, err)
+
+	appleSpec := func(pem []byte) *api.ProjectSpec {
+		return &api.ProjectSpec{
+			ProjectId:   "p-3",
+			NopMode:     true,
+			AllowAlerts: true,
+			Topic:       "im.dlg.dialog-ee",
+			Credentials: &api.ProjectSpec_Apple{Apple: &api.AppleCredentials{Pem: pem}},
+		}
+	}
+
+	t.Run("put", func(t *testing.T) {
+		info, err := admin.PutProject(ctx, &api.ProjectSpec{
+			ProjectId:   "p-2",
+			NopMode:     true,
+			Credentials: &api.ProjectSpec_Google{Google: &api.GoogleCredentials{ServerKey: "server-key"}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, &api.ProjectInfo{
+			ProjectId: "p-2",
+			Kind:      "google",
+			NopMode:   true,
+			Healthy:   true,
+			Source:    api.SourceAdmin,
+		}, info)
+
+		info, err = admin.PutProject(ctx, appleSpec(pem1))
+		require.NoError(t, err)
+		require.Equal(t, "apple", info.Kind)
+
+		for _, projectID := range []string{"p-1", "p-2", "p-3"} {
+			_, err = impl.getWorker(projectID)
+			require.NoError(t, err, projectID)
+		}
+	})
+
+	t.Run("put: invalid", func(t *testing.T) {
+		// the files outside of the store aren't touched by the invalid project ID
+		outside := filepath.Join(dir, "p-4")
+		require.NoError(t, os.MkdirAll(outside, 0700))
+		require.NoError(t, ioutil.WriteFile(filepath.Join(outside, "file"), []byte("data"), 0600))
+		defer func() { require.NoError(t, os.RemoveAll(outside)) }()
+
+		for _, testCase := range []struct {
+			Name string
+			Spec *api.ProjectSpec
+			Code codes.Code
+		}{
+			{"without credentials", &api.ProjectSpec{ProjectId: "p-4"}, codes.InvalidArgument},
+			{"invalid project ID", &api.ProjectSpec{ProjectId: "../p-4", Credentials: &api.ProjectSpec_Google{Google: &api.GoogleCredentials{ServerKey: "key"}}}, codes.InvalidArgument},
+			{"empty project ID", &api.ProjectSpec{ProjectId: "", Credentials: &api.ProjectSpec_Google{Google: &api.GoogleCredentials{ServerKey: "key"}}}, codes.InvalidArgument},
+			{"store root", &api.ProjectSpec{ProjectId: ".", Credentials: &api.ProjectSpec_Google{Google: &api.GoogleCredentials{ServerKey: "key"}}}, codes.InvalidArgument},
+			{"invalid certificate", &api.ProjectSpec{ProjectId: "p-4", Credentials: &api.ProjectSpec_Apple{Apple: &api.AppleCredentials{Pem: []byte("pem")}}}, codes.InvalidArgument},
+			{"config project", &api.ProjectSpec{ProjectId: "p-1", Credentials: &api.ProjectSpec_Google{Google: &api.GoogleCredentials{ServerKey: "key"}}}, codes.FailedPrecondition},
+		} {
+			t.Run(testCase.Name, func(t *testing.T) {
+				_, err := admin.PutProject(ctx, testCase.Spec)
+				require.Equal(t, testCase.Code, status.Code(err), err)
+			})
+		}
+
+		_, err := impl.getWorker("p-4")
+		require.Equal(t, errInvalidProjectID, err)
+
+		// the credential files of the rejected specs are removed
+		_, err = os.Stat(filepath.Join(storeDir, "p-4"))
+		require.True(t, os.IsNotExist(err), err)
+
+		_, err = os.Stat(filepath.Join(outside, "file"))
+		require.NoError(t, err)
+
+		_, err = os.Stat(filepath.Join(storeDir, "p-2"+projectFileExt))
+		require.NoError(t, err)
+
+		// the store refuses the invalid project IDs
+		for _, projectID := range []string{"../p-4", "", ".", ".."} {
+			require.Error(t, admin.reloader.store.discard(projectID), projectID)
+			require.Error(t, admin.reloader.store.remove(projectID), projectID)
+
+			_, err := admin.reloader.store.credentialsPath(projectID, []byte("key"), ".key")
+			require.Error(t, err, projectID)
+		}
+
+		_, err = os.Stat(filepath.Join(outside, "file"))
+		require.NoError(t, err)
+
+		files, err := ioutil.ReadDir(filepath.Join(storeDir, "p-1"))
+		require.True(t, os.IsNotExist(err), files)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		prev, err := impl.getWorker("p-3")
+		require.NoError(t, err)
+
+		_, err = admin.PutProject(ctx, appleSpec(pem2))
+		require.NoError(t, err)
+
+		w, err := impl.getWorker("p-3")
+		require.NoError(t, err)
+		require.False(t, w == prev, "the worker isn't replaced")
+
+		// the credential file of the previous spec is removed
+		files, err := ioutil.ReadDir(filepath.Join(storeDir, "p-3"))
+		require.NoError(t, err)
+		require.Len(t, files, 1)
+
+		// the spec is stored without the credentials
+		data, err := ioutil.ReadFile(filepath.Join(storeDir, "p-3"+projectFileExt))
+		require.NoError(t, err)
+		require.NotContains(t, string(data), base64.StdEncoding.EncodeToString(pem2[:48]))
+
+		data, err = ioutil.ReadFile(filepath.Join(storeDir, "p-2"+projectFileExt))
+		require.NoError(t, err)
+		require.NotContains(t, string(data), "serverKey")
+	})
+
+	t.Run("list", func(t *testing.T) {
+		res, err := admin.ListProjects(ctx, &api.ListProjectsRequest{})
+		require.NoError(t, err)
+		require.Equal(t, []*api.ProjectInfo{
+			{ProjectId: "p-1", Kind: "google", NopMode: true, Healthy: true, Source: api.SourceConfig},
+			{ProjectId: "p-2", Kind: "google", NopMode: true, Healthy: true, Source: api.SourceAdmin},
+			{ProjectId: "p-3", Kind: "apple", NopMode: true, Healthy: true, Source: api.SourceAdmin},
+		}, res.Projects)
+	})
+
+	t.Run("restart", func(t *testing.T) {
+		admin, impl := newAdmin()
+		defer impl.close()
+
+		res, err := admin.ListProjects(ctx, &api.ListProjectsRequest{})
+		require.NoError(t, err)
+		require.Len(t, res.Projects, 3)
+
+		w, err := impl.getWorker("p-3")
+		require.NoError(t, err)
+		require.Equal(t, "im.dlg.dialog-ee", w.ConversionConfig().Topic)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		_, err := admin.DeleteProject(ctx, &api.DeleteProjectRequest{ProjectId: "p-2"})
+		require.NoError(t, err)
+
+		_, err = impl.getWorker("p-2")
+		require.Equal(t, errInvalidProjectID, err)
+
+		_, err = os.Stat(filepath.Join(storeDir, "p-2"+projectFileExt))
+		require.True(t, os.IsNotExist(err))
+
+		_, err = admin.DeleteProject(ctx, &api.DeleteProjectRequest{ProjectId: "p-2"})
+		require.Equal(t, codes.NotFound, status.Code(err))
+
+		_, err = admin.DeleteProject(ctx, &api.DeleteProjectRequest{ProjectId: "p-1"})
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("reload", func(t *testing.T) {
+		// the project of the config file conflicts with the stored project
+		require.NoError(t, ioutil.WriteFile(file, []byte(`
+google:
+  - project-id: p-1
+    key: server-key
+    nop-mode: true
+  - project-id: p-3
+    key: server-key
+    nop-mode: true
+`), 0600))
+
+		_, err := admin.reloader.reload()
+		require.EqualError(t, err, "not unique project id of a worker:p-3")
+
+		w, err := impl.getWorker("p-3")
+		require.NoError(t, err)
+		require.Equal(t, "apple", w.Kind().String())
+	})
+
+	t.Run("http", func(t *testing.T) {
+		doAs := func(apiKey, method, target, body string) *httptest.ResponseRecorder {
+			t.Helper()
+
+			req := httptest.NewRequest(method, target, strings.NewReader(body))
+			if apiKey != "" {
+				req.Header.Set(restAPIKeyHeader, apiKey)
+			}
+
+			rec := httptest.NewRecorder()
+			admin.ServeHTTP(rec, req)
+			return rec
+		}
+
+		do := func(method, target, body string) *httptest.ResponseRecorder {
+			t.Helper()
+			return doAs("admin-key", method, target, body)
+		}
+
+		// the admin client is required
+		rec := doAs("", http.MethodGet, projectsPath, "")
+		require.Equal(t, http.StatusUnauthorized, rec.Code, rec.Body.String())
+
+		rec = doAs("messenger-key", http.MethodDelete, projectsPath+"?project-id=p-3", "")
+		require.Equal(t, http.StatusForbidden, rec.Code, rec.Body.String())
+
+		rec = do(http.MethodPost, projectsPath, `{"projectId": "p-5", "nopMode": true, "google": {"serverKey": "server-key"}}`)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+		info := &api.ProjectInfo{}
+		require.NoError(t, jsonpb.UnmarshalString(rec.Body.String(), info))
+		require.Equal(t, "p-5", info.ProjectId)
+
+		rec = do(http.MethodGet, projectsPath, "")
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		list := &api.ListProjectsResponse{}
+		require.NoError(t, jsonpb.UnmarshalString(rec.Body.String(), list))
+		require.Len(t, list.Projects, 3)
+
+		rec = do(http.MethodDelete, projectsPath+"?project-id=p-5", "")
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+		rec = do(http.MethodDelete, projectsPath+"?project-id=p-5", "")
+		require.Equal(t, http.StatusNotFound, rec.Code, rec.Body.String())
+
+		rec = do(http.MethodPost, projectsPath, `{"projectId": "p-5", "unknown": 1}`)
+		require.Equal(t, http.StatusBadRequest, rec.Code, rec.Body.String())
+
+		rec = do(http.MethodPut, projectsPath, "")
+		require.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	})
+
+	t.Run("http reload", func(t *testing.T) {
+		doAs := func(apiKey, method string) *httptest.ResponseRecorder {
+			t.Helper()
+
+			req := httptest.NewRequest(method, reloadPath, nil)
+			if apiKey != "" {
+				req.Header.Set(restAPIKeyHeader, apiKey)
+			}
+
+			rec := httptest.NewRecorder()
+			admin.ServeReload(rec, req)
+			return rec
+		}
+
+		// the admin client is required to reload
+		rec := doAs("", http.MethodPost)
+		require.Equal(t, http.StatusUnauthorized, rec.Code, rec.Body.String())
+
+		rec = doAs("messenger-key", http.MethodPost)
+		require.Equal(t, http.StatusForbidden, rec.Code, rec.Body.String())
+
+		// the config file still conflicts with the stored project
+		rec = doAs("admin-key", http.MethodPost)
+		require.Equal(t, http.StatusInternalServerError, rec.Code, rec.Body.String())
+
+		res := &ReloadResult{}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), res))
+		require.NotZero(t, res.Time)
+		require.Equal(t, "not unique project id of a worker:p-3", res.Error)
+
+		// the result of the last reload is available to any client
+		rec = doAs("", http.MethodGet)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+		last := &ReloadResult{}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), last))
+		require.Equal(t, res.Time.UnixNano(), last.Time.UnixNano())
+
+		rec = doAs("", http.MethodPut)
+		require.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	})
+}
+
+func TestProjectAdminDisabled(t *testing.T) {
+
+	v := viper.New()
+	cfg, err := NewConfig(v)
+	require.NoError(t, err)
+
+	impl, err := newImplGRPC(cfg, zap.NewNop())
+	require.NoError(t, err)
+
+	r, err := newReloader(v, cfg, impl, nil, time.Second, zap.NewNop())
+	require.NoError(t, err)
+
+	auth, err := newAuthorizer(nil)
+	require.NoError(t, err)
+
+	admin := newProjectAdmin(r, auth, zap.NewNop())
+
+	// the HTTP endpoint is disabled without the clients
+	rec := httptest.NewRecorder()
+	admin.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, projectsPath, nil))
+	require.Equal(t, http.StatusForbidden, rec.Code, rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	admin.ServeReload(rec, httptest.NewRequest(http.MethodPost, reloadPath, nil))
+	require.Equal(t, http.StatusForbidden, rec.Code, rec.Body.String())
+
+	// the result of the last reload is available without the clients
+	rec = httptest.NewRecorder()
+	admin.ServeReload(rec, httptest.NewRequest(http.MethodGet, reloadPath, nil))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	_, err = admin.PutProject(context.Background(), &api.ProjectSpec{ProjectId: "p-1"})
+	require.Equal(t, errProjectStoreDisabled, err)
+
+	_, err = admin.DeleteProject(context.Background(), &api.DeleteProjectRequest{ProjectId: "p-1"})
+	require.Equal(t, errProjectStoreDisabled, err)
+
+	res, err := admin.ListProjects(context.Background(), &api.ListProjectsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.Projects)
+}
@@ -0,0 +1,427 @@
+package service
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/dialogs/dialog-push-service/pkg/api"
+	"github.com/dialogs/dialog-push-service/pkg/atomicfile"
+	"github.com/dialogs/dialog-push-service/pkg/worker/ans"
+	"github.com/dialogs/dialog-push-service/pkg/worker/fcm"
+	"github.com/dialogs/dialog-push-service/pkg/worker/gcm"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+)
+
+const projectFileExt = ".json"
+
+// projectIDPattern limits the project IDs of ProjectAdmin: the ID is a file name of the store
+var projectIDPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]{0,127}$`)
+
+// checkProjectID returns an error if the project ID isn't a safe file name of the store:
+// the files outside of the store must not be read or removed by the ID
+func checkProjectID(projectID string) error {
+
+	if !projectIDPattern.MatchString(projectID) {
+		return errors.New("invalid project ID: " + projectID)
+	}
+
+	return nil
+}
+
+// projectStore persists the projects of ProjectAdmin. The spec of a project without
+// the credentials is stored in `<dir>/<project ID>.json`. The credential files are written
+// to `<dir>/<project ID>/` by the hash of the content: the files of the running worker
+// aren't replaced by the update
+type projectStore struct {
+	dir string
+}
+
+// projectFile is the content of `<project ID>.json`
+type projectFile struct {
+	// Spec is the spec without the credentials in the protobuf JSON mapping
+	Spec json.RawMessage `json:"spec"`
+	// Credentials are the names of the credential files by the credential name
+	Credentials map[string]string `json:"credentials"`
+}
+
+// credential is a secret of the spec which is stored in a separate file
+type credential struct {
+	name string
+	ext  string
+	get  func(*api.ProjectSpec) []byte
+	set  func(*api.ProjectSpec, []byte)
+}
+
+var specCredentials = []credential{
+	{
+		name: "pem",
+		ext:  ".pem",
+		get:  func(spec *api.ProjectSpec) []byte { return spec.GetApple().GetPem() },
+		set: func(spec *api.ProjectSpec, data []byte) {
+			if apple := spec.GetApple(); apple != nil {
+				apple.Pem = data
+			}
+		},
+	},
+	{
+		name: "key",
+		ext:  ".p8",
+		get:  func(spec *api.ProjectSpec) []byte { return spec.GetApple().GetKey() },
+		set: func(spec *api.ProjectSpec, data []byte) {
+			if apple := spec.GetApple(); apple != nil {
+				apple.Key = data
+			}
+		},
+	},
+	{
+		name: "service-account",
+		ext:  ".json",
+		get:  func(spec *api.ProjectSpec) []byte { return spec.GetFcm().GetServiceAccount() },
+		set: func(spec *api.ProjectSpec, data []byte) {
+			if fcm := spec.GetFcm(); fcm != nil {
+				fcm.ServiceAccount = data
+			}
+		},
+	},
+	{
+		name: "server-key",
+		ext:  ".key",
+		get:  func(spec *api.ProjectSpec) []byte { return []byte(spec.GetGoogle().GetServerKey()) },
+		set: func(spec *api.ProjectSpec, data []byte) {
+			if google := spec.GetGoogle(); google != nil {
+				google.ServerKey = string(data)
+			}
+		},
+	},
+}
+
+func openProjectStore(dir string) (*projectStore, error) {
+
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, errors.Wrap(err, "project store")
+	}
+
+	return &projectStore{dir: dir}, nil
+}
+
+// load reads the stored projects
+func (s *projectStore) load() ([]*api.ProjectSpec, error) {
+
+	files, err := ioutil.ReadDir(s.dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "project store")
+	}
+
+	retval := make([]*api.ProjectSpec, 0, len(files))
+	for _, info := range files {
+		name := info.Name()
+		path := filepath.Join(s.dir, name)
+
+		switch {
+		case info.IsDir():
+			continue
+
+		case strings.HasSuffix(name, atomicfile.TmpExt):
+			// the project wasn't written completely
+			if err := os.Remove(path); err != nil {
+				return nil, errors.Wrap(err, "project store")
+			}
+			continue
+
+		case !strings.HasSuffix(name, projectFileExt):
+			continue
+		}
+
+		spec, err := s.read(path)
+		if err != nil {
+			return nil, errors.Wrap(err, "project store: "+name)
+		}
+
+		retval = append(retval, spec)
+	}
+
+	return retval, nil
+}
+
+// read reads the spec and the credential files of the project
+func (s *projectStore) read(path string) (*api.ProjectSpec, error) {
+
+	file, err := readProjectFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	spec := &api.ProjectSpec{}
+	if err := jsonpb.Unmarshal(bytes.NewReader(file.Spec), spec); err != nil {
+		return nil, err
+	}
+
+	if err := checkProjectID(spec.ProjectId); err != nil {
+		return nil, err
+	}
+
+	for _, c := range specCredentials {
+		name, ok := file.Credentials[c.name]
+		if !ok {
+			continue
+		}
+
+		data, err := ioutil.ReadFile(filepath.Join(s.dir, spec.ProjectId, filepath.Base(name)))
+		if err != nil {
+			return nil, err
+		}
+
+		c.set(spec, data)
+	}
+
+	return spec, nil
+}
+
+// config writes the credential files of the project and returns the worker config
+func (s *projectStore) config(spec *api.ProjectSpec) (interface{}, error) {
+
+	if err := checkProjectID(spec.ProjectId); err != nil {
+		return nil, err
+	}
+
+	src := viper.New()
+	src.Set("project-id", spec.ProjectId)
+	src.Set("sandbox", spec.Sandbox)
+	src.Set("nop-mode", spec.NopMode)
+	src.Set("allow-alerts", spec.AllowAlerts)
+	src.Set("topic", spec.Topic)
+	src.Set("sound", spec.Sound)
+
+	var (
+		c   interface{}
+		err error
+	)
+
+	switch credentials := spec.Credentials.(type) {
+	case *api.ProjectSpec_Apple:
+		apple := credentials.Apple
+
+		if len(apple.Pem) > 0 {
+			name, err := s.writeCredentials(spec.ProjectId, apple.Pem, ".pem")
+			if err != nil {
+				return nil, err
+			}
+			src.Set("pem", name)
+		}
+
+		if len(apple.Key) > 0 {
+			name, err := s.writeCredentials(spec.ProjectId, apple.Key, ".p8")
+			if err != nil {
+				return nil, err
+			}
+			src.Set("key-file", name)
+			src.Set("key-id", apple.KeyId)
+			src.Set("team-id", apple.TeamId)
+			src.Set("voip", apple.Voip)
+		}
+
+		c, err = ans.NewConfig(src)
+
+	case *api.ProjectSpec_Fcm:
+		var name string
+		name, err = s.writeCredentials(spec.ProjectId, credentials.Fcm.ServiceAccount, ".json")
+		if err != nil {
+			return nil, err
+		}
+		src.Set("service-account", name)
+
+		c, err = fcm.NewConfig(src)
+
+	case *api.ProjectSpec_Google:
+		src.Set("key", credentials.Google.ServerKey)
+
+		c, err = gcm.NewConfig(src)
+
+	default:
+		err = errors.New("credentials are required")
+	}
+
+	if err != nil {
+		return nil, errors.Wrap(err, "project ID: "+spec.ProjectId)
+	}
+
+	return c, nil
+}
+
+// save writes the spec of the project and removes the credential files of the previous spec
+func (s *projectStore) save(spec *api.ProjectSpec) error {
+
+	file := &projectFile{
+		Credentials: make(map[string]string),
+	}
+
+	// the spec is stored without the credentials
+	stored := proto.Clone(spec).(*api.ProjectSpec)
+	for _, c := range specCredentials {
+		data := c.get(spec)
+		if len(data) == 0 {
+			continue
+		}
+
+		path, err := s.writeCredentials(spec.ProjectId, data, c.ext)
+		if err != nil {
+			return err
+		}
+
+		file.Credentials[c.name] = filepath.Base(path)
+		c.set(stored, nil)
+	}
+
+	marshaler := jsonpb.Marshaler{}
+	data, err := marshaler.MarshalToString(stored)
+	if err != nil {
+		return errors.Wrap(err, "project store")
+	}
+	file.Spec = json.RawMessage(data)
+
+	content, err := json.Marshal(file)
+	if err != nil {
+		return errors.Wrap(err, "project store")
+	}
+
+	if err := atomicfile.Write(filepath.Join(s.dir, spec.ProjectId+projectFileExt), content); err != nil {
+		return errors.Wrap(err, "project store")
+	}
+
+	return s.removeCredentials(spec.ProjectId, file.Credentials)
+}
+
+// discard removes the credential files of the project which aren't used
+// by the stored spec: for example, the files of the rejected spec
+func (s *projectStore) discard(projectID string) error {
+
+	if err := checkProjectID(projectID); err != nil {
+		return errors.Wrap(err, "project store")
+	}
+
+	file, err := readProjectFile(filepath.Join(s.dir, projectID+projectFileExt))
+	if os.IsNotExist(errors.Cause(err)) {
+		file, err = &projectFile{}, nil
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "project store")
+	}
+
+	return s.removeCredentials(projectID, file.Credentials)
+}
+
+// removeCredentials removes the credential files of the project except the used files.
+// The directory of the project is removed if the project hasn't credential files
+func (s *projectStore) removeCredentials(projectID string, used map[string]string) error {
+
+	keep := make(map[string]struct{}, len(used))
+	for _, name := range used {
+		keep[filepath.Base(name)] = struct{}{}
+	}
+
+	dir := filepath.Join(s.dir, projectID)
+	files, err := ioutil.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return errors.Wrap(err, "project store")
+	}
+
+	for _, info := range files {
+		if _, ok := keep[info.Name()]; !ok {
+			if err := os.Remove(filepath.Join(dir, info.Name())); err != nil {
+				return errors.Wrap(err, "project store")
+			}
+		}
+	}
+
+	if len(keep) == 0 {
+		if err := os.Remove(dir); err != nil && !os.IsNotExist(err) {
+			return errors.Wrap(err, "project store")
+		}
+	}
+
+	return nil
+}
+
+// remove removes the spec and the credential files of the project
+func (s *projectStore) remove(projectID string) error {
+
+	if err := checkProjectID(projectID); err != nil {
+		return errors.Wrap(err, "project store")
+	}
+
+	err := os.Remove(filepath.Join(s.dir, projectID+projectFileExt))
+	if err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "project store")
+	}
+
+	if err := os.RemoveAll(filepath.Join(s.dir, projectID)); err != nil {
+		return errors.Wrap(err, "project store")
+	}
+
+	return nil
+}
+
+// writeCredentials writes the credential file if the file doesn't exist
+func (s *projectStore) writeCredentials(projectID string, data []byte, ext string) (string, error) {
+
+	if len(data) == 0 {
+		return "", errors.New("empty credentials")
+	}
+
+	path, err := s.credentialsPath(projectID, data, ext)
+	if err != nil {
+		return "", errors.Wrap(err, "project store")
+	}
+
+	if _, err := os.Stat(path); err == nil {
+		return path, nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return "", errors.Wrap(err, "project store")
+	}
+
+	if err := atomicfile.Write(path, data); err != nil {
+		return "", errors.Wrap(err, "project store")
+	}
+
+	return path, nil
+}
+
+func (s *projectStore) credentialsPath(projectID string, data []byte, ext string) (string, error) {
+
+	if err := checkProjectID(projectID); err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(data)
+	return filepath.Join(s.dir, projectID, hex.EncodeToString(hash[:8])+ext), nil
+}
+
+func readProjectFile(path string) (*projectFile, error) {
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	file := &projectFile{}
+	if err := json.Unmarshal(data, file); err != nil {
+		return nil, err
+	}
+
+	return file, nil
+}
@@ -0,0 +1,449 @@
+package service
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/dialogs/dialog-push-service/pkg/worker"
+	"github.com/dialogs/dialog-push-service/pkg/worker/ans"
+	"github.com/dialogs/dialog-push-service/pkg/worker/fcm"
+	"github.com/dialogs/dialog-push-service/pkg/worker/gcm"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+// reloadDrainInterval is the interval of checking the requests in flight of a replaced worker
+const reloadDrainInterval = 100 * time.Millisecond
+
+// ReloadResult is the result of the configuration reload
+type ReloadResult struct {
+	Time      time.Time `json:"time"`
+	Added     []string  `json:"added"`
+	Updated   []string  `json:"updated"`
+	Removed   []string  `json:"removed"`
+	Unchanged []string  `json:"unchanged"`
+	// Ignored are the changed settings which are applied after the restart
+	Ignored []string `json:"ignored,omitempty"`
+	Error   string   `json:"error,omitempty"`
+}
+
+// reloader applies the projects of the changed configuration and the projects of ProjectAdmin
+// without the restart. The workers of the added and the changed projects are created before
+// the workers are replaced: if any worker can't be created, the change isn't applied.
+// The replaced workers are closed after the requests in flight are finished
+type reloader struct {
+	mu     sync.Mutex
+	src    *viper.Viper
+	cfg    *Config
+	impl   *implGRPC
+	logger *zap.Logger
+	// workerLogger is the service logger: the logger of the created workers
+	workerLogger *zap.Logger
+	// store persists the projects of ProjectAdmin. Nil if `projects-dir` isn't set
+	store *projectStore
+	// stored are the configs of the projects of ProjectAdmin by project ID
+	stored map[string]interface{}
+	// signatures are the hashes of the project configs and the credential files
+	signatures   map[string]string
+	drainTimeout time.Duration
+	last         *ReloadResult
+}
+
+// projectsUpdate is the prepared set of the workers. The update is applied by commit or discarded
+type projectsUpdate struct {
+	workers    map[string]worker.IWorker
+	signatures map[string]string
+	created    []worker.IWorker
+	replaced   []worker.IWorker
+}
+
+func newReloader(src *viper.Viper, cfg *Config, impl *implGRPC, store *projectStore, drainTimeout time.Duration, logger *zap.Logger) (*reloader, error) {
+
+	r := &reloader{
+		src:          src,
+		cfg:          cfg,
+		impl:         impl,
+		logger:       logger.With(zap.String("component", "reload")),
+		workerLogger: logger,
+		store:        store,
+		stored:       make(map[string]interface{}),
+		signatures:   make(map[string]string),
+		drainTimeout: drainTimeout,
+	}
+
+	// the workers of the config file are created by the service
+	err := cfg.WalkConfigs(func(c interface{}) error {
+		projectID, signature, err := projectSignature(c)
+		if err != nil {
+			return err
+		}
+
+		r.signatures[projectID] = signature
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if store != nil {
+		specs, err := store.load()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, spec := range specs {
+			c, err := store.config(spec)
+			if err != nil {
+				return nil, errors.Wrap(err, "project store")
+			}
+
+			r.stored[spec.ProjectId] = c
+		}
+
+		update, err := r.prepare(cfg, r.stored, &ReloadResult{})
+		if err != nil {
+			return nil, err
+		}
+		r.commit(update)
+	}
+
+	r.last = &ReloadResult{
+		Time: time.Now(),
+	}
+	for projectID := range r.signatures {
+		r.last.Added = append(r.last.Added, projectID)
+	}
+	sort.Strings(r.last.Added)
+
+	return r, nil
+}
+
+// reload re-reads the config file and applies the projects
+func (r *reloader) reload() (*ReloadResult, error) {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	res := &ReloadResult{
+		Time: time.Now(),
+	}
+
+	err := r.reloadConfig(res)
+	if err != nil {
+		res.Error = err.Error()
+		r.logger.Error("reload", zap.Error(err))
+	} else {
+		r.logger.Info("reload",
+			zap.Strings("added", res.Added),
+			zap.Strings("updated", res.Updated),
+			zap.Strings("removed", res.Removed),
+			zap.Int("unchanged", len(res.Unchanged)))
+
+		if len(res.Ignored) > 0 {
+			r.logger.Warn("reload: the settings are applied after the restart", zap.Strings("ignored", res.Ignored))
+		}
+	}
+
+	r.last = res
+	return res, err
+}
+
+func (r *reloader) reloadConfig(res *ReloadResult) error {
+
+	if r.src.ConfigFileUsed() != "" {
+		if err := r.src.ReadInConfig(); err != nil {
+			return errors.Wrap(err, "read config")
+		}
+	}
+
+	cfg, err := NewConfig(r.src)
+	if err != nil {
+		return err
+	}
+
+	update, err := r.prepare(cfg, r.stored, res)
+	if err != nil {
+		return err
+	}
+
+	r.commit(update)
+	res.Ignored = ignoredSettings(r.cfg, cfg)
+	r.cfg = cfg
+
+	return nil
+}
+
+// prepare creates the workers of the added and the changed projects of the config file
+// and the stored projects. The workers of the unchanged projects are reused
+func (r *reloader) prepare(cfg *Config, stored map[string]interface{}, res *ReloadResult) (*projectsUpdate, error) {
+
+	current := r.impl.currentWorkers()
+
+	update := &projectsUpdate{
+		workers:    make(map[string]worker.IWorker),
+		signatures: make(map[string]string),
+		created:    make([]worker.IWorker, 0),
+		replaced:   make([]worker.IWorker, 0),
+	}
+
+	add := func(c interface{}) error {
+		projectID, signature, err := projectSignature(c)
+		if err != nil {
+			return err
+		}
+
+		if _, ok := update.signatures[projectID]; ok {
+			return errors.New("not unique project id of a worker:" + projectID)
+		}
+		update.signatures[projectID] = signature
+
+		prev, ok := current[projectID]
+		if ok && r.signatures[projectID] == signature {
+			update.workers[projectID] = prev
+			res.Unchanged = append(res.Unchanged, projectID)
+			return nil
+		}
+
+		w, err := newWorker(c, r.workerLogger, r.impl.metric)
+		if err != nil {
+			return err
+		}
+
+		update.created = append(update.created, w)
+		update.workers[projectID] = w
+
+		if ok {
+			res.Updated = append(res.Updated, projectID)
+		} else {
+			res.Added = append(res.Added, projectID)
+		}
+
+		return nil
+	}
+
+	err := cfg.WalkConfigs(add)
+	if err == nil {
+		for _, c := range stored {
+			if err = add(c); err != nil {
+				break
+			}
+		}
+	}
+
+	if err != nil {
+		r.discard(update)
+		res.Added, res.Updated, res.Unchanged = nil, nil, nil
+		return nil, err
+	}
+
+	for projectID, w := range current {
+		if update.workers[projectID] == w {
+			continue
+		}
+
+		if _, ok := update.workers[projectID]; !ok {
+			res.Removed = append(res.Removed, projectID)
+		}
+
+		update.replaced = append(update.replaced, w)
+	}
+
+	for _, list := range [][]string{res.Added, res.Updated, res.Removed, res.Unchanged} {
+		sort.Strings(list)
+	}
+
+	return update, nil
+}
+
+// commit replaces the workers. The replaced workers are closed after the requests in flight
+func (r *reloader) commit(update *projectsUpdate) {
+
+	r.impl.setWorkers(update.workers)
+	r.signatures = update.signatures
+
+	for _, w := range update.replaced {
+		go r.retire(w)
+	}
+}
+
+// discard closes the created workers: the update isn't applied
+func (r *reloader) discard(update *projectsUpdate) {
+
+	for _, w := range update.created {
+		w.Close()
+		r.deleteMetrics(w)
+	}
+}
+
+// retire closes the replaced worker after the requests in flight are finished
+// or the drain timeout ends
+func (r *reloader) retire(w worker.IWorker) {
+
+	l := r.logger.With(zap.String("project id", w.ProjectID()))
+
+	deadline := time.Now().Add(r.drainTimeout)
+	for w.InFlight() > 0 && time.Now().Before(deadline) {
+		time.Sleep(reloadDrainInterval)
+	}
+
+	if count := w.InFlight(); count > 0 {
+		l.Warn("close replaced worker: drain timeout", zap.Int("in flight", count))
+	}
+
+	w.Close()
+
+	r.mu.Lock()
+	r.deleteMetrics(w)
+	r.mu.Unlock()
+
+	l.Info("replaced worker is closed")
+}
+
+// deleteMetrics deletes the gauges of the closed worker if the project isn't served
+// by a worker of the same kind: the gauges are shared by the workers of the project.
+// The workers are created and replaced under r.mu
+func (r *reloader) deleteMetrics(w worker.IWorker) {
+
+	if current, ok := r.impl.currentWorkers()[w.ProjectID()]; ok && current.Kind() == w.Kind() {
+		return
+	}
+
+	r.impl.metric.DeleteProviderMetrics(w.Kind().String(), w.ProjectID())
+}
+
+func (r *reloader) lastResult() *ReloadResult {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.last
+}
+
+// ServeHTTP returns the result of the last reload (GET) or reloads the configuration (POST)
+func (r *reloader) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+
+	var (
+		res  *ReloadResult
+		code = http.StatusOK
+	)
+
+	switch req.Method {
+	case http.MethodGet:
+		res = r.lastResult()
+
+	case http.MethodPost:
+		var err error
+		res, err = r.reload()
+		if err != nil {
+			code = http.StatusInternalServerError
+		}
+
+	default:
+		w.Header().Set("Allow", http.MethodGet+", "+http.MethodPost)
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	data, err := json.Marshal(res)
+	if err != nil {
+		r.logger.Error("reload result to json", zap.Error(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+
+	if _, err := w.Write(data); err != nil {
+		r.logger.Error("write reload result", zap.Error(err))
+	}
+}
+
+// projectSignature returns the project ID and the hash of the project config and
+// the credential files: the project is updated if the content of a file is changed
+func projectSignature(c interface{}) (string, string, error) {
+
+	base, files := projectConfig(c)
+	if base == nil {
+		return "", "", fmt.Errorf("unknown config type: %T", c)
+	}
+
+	projectID := base.ProjectID
+
+	data, err := json.Marshal(c)
+	if err != nil {
+		return "", "", errors.Wrap(err, "project ID: "+projectID)
+	}
+
+	h := sha256.New()
+	h.Write(data)
+
+	for _, name := range files {
+		if name == "" {
+			continue
+		}
+
+		data, err := ioutil.ReadFile(name)
+		if err != nil {
+			return "", "", errors.Wrap(err, "project ID: "+projectID)
+		}
+
+		h.Write([]byte(name))
+		h.Write(data)
+	}
+
+	return projectID, hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// projectConfig returns the common settings and the credential files of the project config.
+// The settings are nil if the config type is unknown
+func projectConfig(c interface{}) (*worker.Config, []string) {
+
+	switch cfg := c.(type) {
+	case *ans.Config:
+		return cfg.Config, []string{cfg.PemFile, cfg.KeyFile, cfg.CAFile}
+	case *gcm.Config:
+		return cfg.Config, []string{cfg.CAFile}
+	case *fcm.Config:
+		return cfg.Config, []string{cfg.ServiceAccount, cfg.CAFile}
+	}
+
+	return nil, nil
+}
+
+// ignoredSettings returns the changed settings which can't be reloaded
+func ignoredSettings(prev, next *Config) []string {
+
+	settings := []struct {
+		name       string
+		prev, next interface{}
+	}{
+		{"grpc-port", prev.ApiPort, next.ApiPort},
+		{"http-port", prev.AdminPort, next.AdminPort},
+		{"rest-port", prev.RestPort, next.RestPort},
+		{"stream-max-in-flight", prev.StreamMaxInFlight, next.StreamMaxInFlight},
+		{"shutdown-timeout", prev.ShutdownTimeout, next.ShutdownTimeout},
+		{"retry", prev.Retry, next.Retry},
+		{"grpc-tls", prev.GRPCTLS, next.GRPCTLS},
+		{"clients", prev.Clients, next.Clients},
+		{"projects-dir", prev.ProjectsDir, next.ProjectsDir},
+	}
+
+	retval := make([]string, 0)
+	for _, setting := range settings {
+		if !reflect.DeepEqual(setting.prev, setting.next) {
+			retval = append(retval, setting.name)
+		}
+	}
+
+	return retval
+}
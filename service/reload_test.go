@@ -0,0 +1,280 @@
+package service
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/dialogs/dialog-push-service/pkg/conversion"
+	"github.com/dialogs/dialog-push-service/pkg/metric"
+	"github.com/dialogs/dialog-push-service/pkg/worker"
+	"github.com/dialogs/dialog-push-service/pkg/worker/ans"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestReload(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "reload")
+	require.NoError(t, err)
+	defer func() { require.NoError(t, os.RemoveAll(dir)) }()
+
+	file := filepath.Join(dir, "config.yaml")
+	writeConfig := func(src string) {
+		t.Helper()
+		require.NoError(t, ioutil.WriteFile(file, []byte(src), 0600))
+	}
+
+	writeConfig(`
+grpc-port: 8010
+google:
+  - project-id: p-1
+    key: server-key
+    nop-mode: true
+  - project-id: p-2
+    key: server-key
+    nop-mode: true
+  - project-id: p-3
+    key: server-key
+    nop-mode: true
+`)
+
+	v := viper.New()
+	v.SetConfigFile(file)
+	require.NoError(t, v.ReadInConfig())
+
+	cfg, err := NewConfig(v)
+	require.NoError(t, err)
+
+	impl, err := newImplGRPC(cfg, zap.NewNop())
+	require.NoError(t, err)
+	defer impl.close()
+
+	r, err := newReloader(v, cfg, impl, nil, time.Second, zap.NewNop())
+	require.NoError(t, err)
+	require.Equal(t, []string{"p-1", "p-2", "p-3"}, r.lastResult().Added)
+
+	p1, err := impl.getWorker("p-1")
+	require.NoError(t, err)
+
+	p2, err := impl.getWorker("p-2")
+	require.NoError(t, err)
+
+	t.Run("projects", func(t *testing.T) {
+		writeConfig(`
+grpc-port: 8020
+google:
+  - project-id: p-1
+    key: server-key
+    nop-mode: true
+  - project-id: p-2
+    key: server-key
+    nop-mode: true
+    workers: 2
+  - project-id: p-4
+    key: server-key
+    nop-mode: true
+`)
+
+		res, err := r.reload()
+		require.NoError(t, err)
+		require.Equal(t, []string{"p-4"}, res.Added)
+		require.Equal(t, []string{"p-2"}, res.Updated)
+		require.Equal(t, []string{"p-3"}, res.Removed)
+		require.Equal(t, []string{"p-1"}, res.Unchanged)
+		require.Equal(t, []string{"grpc-port"}, res.Ignored)
+		require.Empty(t, res.Error)
+
+		w, err := impl.getWorker("p-1")
+		require.NoError(t, err)
+		require.True(t, w == p1, "the unchanged worker is replaced")
+
+		w, err = impl.getWorker("p-2")
+		require.NoError(t, err)
+		require.False(t, w == p2, "the changed worker isn't replaced")
+
+		_, err = impl.getWorker("p-3")
+		require.Equal(t, errInvalidProjectID, err)
+
+		_, err = impl.getWorker("p-4")
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid config", func(t *testing.T) {
+		writeConfig(`
+grpc-port: 8020
+google:
+  - project-id: p-1
+    key: server-key
+    nop-mode: true
+  - project-id: p-1
+    key: server-key
+    nop-mode: true
+    workers: 2
+`)
+
+		res, err := r.reload()
+		require.EqualError(t, err, "not unique project id of a worker:p-1")
+		require.Equal(t, err.Error(), res.Error)
+		require.Empty(t, res.Added)
+		require.Empty(t, res.Updated)
+		require.Empty(t, res.Removed)
+
+		// the workers aren't changed
+		for _, projectID := range []string{"p-1", "p-2", "p-4"} {
+			_, err = impl.getWorker(projectID)
+			require.NoError(t, err, projectID)
+		}
+	})
+
+	t.Run("admin", func(t *testing.T) {
+		do := func(method string) (*httptest.ResponseRecorder, *ReloadResult) {
+			t.Helper()
+
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, httptest.NewRequest(method, "/reload", nil))
+
+			res := &ReloadResult{}
+			if rec.Code != http.StatusMethodNotAllowed {
+				require.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), res))
+			}
+
+			return rec, res
+		}
+
+		// the result of the last reload
+		rec, res := do(http.MethodGet)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, "not unique project id of a worker:p-1", res.Error)
+
+		rec, res = do(http.MethodPost)
+		require.Equal(t, http.StatusInternalServerError, rec.Code)
+		require.NotEmpty(t, res.Error)
+
+		writeConfig(`
+grpc-port: 8020
+google:
+  - project-id: p-1
+    key: server-key
+    nop-mode: true
+`)
+
+		rec, res = do(http.MethodPost)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		require.Empty(t, res.Error)
+		require.Equal(t, []string{"p-2", "p-4"}, res.Removed)
+		require.Equal(t, []string{"p-1"}, res.Unchanged)
+		require.Empty(t, res.Ignored)
+
+		rec, _ = do(http.MethodPut)
+		require.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+		require.Equal(t, "GET, POST", rec.Header().Get("Allow"))
+	})
+}
+
+func TestReloadRetire(t *testing.T) {
+
+	r := &reloader{
+		impl: &implGRPC{
+			metric:  metric.New(),
+			workers: map[string]worker.IWorker{},
+		},
+		logger:       zap.NewNop(),
+		drainTimeout: time.Second,
+	}
+
+	w := &retiredWorker{
+		inFlight: 1,
+		closed:   make(chan struct{}),
+	}
+	go r.retire(w)
+
+	// the worker isn't closed while the requests are in flight
+	select {
+	case <-w.closed:
+		require.Fail(t, "the worker is closed with the requests in flight")
+	case <-time.After(3 * reloadDrainInterval / 2):
+	}
+
+	atomic.StoreInt32(&w.inFlight, 0)
+
+	select {
+	case <-w.closed:
+	case <-time.After(time.Second):
+		require.Fail(t, "the worker isn't closed")
+	}
+}
+
+func TestProjectSignature(t *testing.T) {
+
+	pem, err := ioutil.TempFile("", "signature")
+	require.NoError(t, err)
+	require.NoError(t, pem.Close())
+	defer func() { require.NoError(t, os.Remove(pem.Name())) }()
+
+	cfg := &ans.Config{
+		Config: &worker.Config{
+			Config:    &conversion.Config{Topic: "im.dlg.dialog-ee"},
+			ProjectID: "p-1",
+		},
+		PemFile: pem.Name(),
+	}
+
+	signature := func() string {
+		t.Helper()
+
+		projectID, signature, err := projectSignature(cfg)
+		require.NoError(t, err)
+		require.Equal(t, "p-1", projectID)
+
+		return signature
+	}
+
+	require.NoError(t, ioutil.WriteFile(pem.Name(), []byte("certificate 1"), 0600))
+	s1 := signature()
+	require.Equal(t, s1, signature())
+
+	// the certificate is renewed
+	require.NoError(t, ioutil.WriteFile(pem.Name(), []byte("certificate 2"), 0600))
+	s2 := signature()
+	require.NotEqual(t, s1, s2)
+
+	// the config is changed
+	cfg.Topic = "im.dlg.dialog"
+	require.NotEqual(t, s2, signature())
+
+	cfg.PemFile = pem.Name() + ".unknown"
+	_, _, err = projectSignature(cfg)
+	require.Error(t, err)
+}
+
+// retiredWorker is a replaced worker with the requests in flight
+type retiredWorker struct {
+	worker.IWorker
+	inFlight int32
+	closed   chan struct{}
+}
+
+func (w *retiredWorker) ProjectID() string {
+	return "p-1"
+}
+
+func (w *retiredWorker) Kind() worker.Kind {
+	return worker.KindGcm
+}
+
+func (w *retiredWorker) InFlight() int {
+	return int(atomic.LoadInt32(&w.inFlight))
+}
+
+func (w *retiredWorker) Close() {
+	close(w.closed)
+}
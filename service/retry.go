@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/dialogs/dialog-push-service/pkg/provider"
+	"github.com/dialogs/dialog-push-service/pkg/retry"
+	"github.com/dialogs/dialog-push-service/pkg/worker"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+var errRetryNoResponse = errors.New("retry: no worker response")
+
+// spill stores the failed notification to the retry queue
+func (i *implGRPC) spill(res *worker.Response, correlationID string, priority worker.Priority, payload []byte, ttl time.Duration) error {
+
+	return i.retry.Push(&retry.Item{
+		ProjectID:     res.ProjectID,
+		DeviceToken:   res.DeviceToken,
+		CorrelationID: correlationID,
+		Priority:      int(priority),
+		Payload:       payload,
+	}, ttl)
+}
+
+// replay sends the notification of the retry queue again
+func (i *implGRPC) replay(ctx context.Context, item *retry.Item) error {
+
+	l := i.logger.With(
+		zap.String("method", "retry"),
+		zap.String("project id", item.ProjectID),
+		zap.String("correlation id", item.CorrelationID))
+
+	w, err := i.getWorker(item.ProjectID)
+	if err != nil {
+		return retry.Permanent(err)
+	}
+
+	payload := w.NewRequest()
+	if err := json.Unmarshal(item.Payload, payload); err != nil {
+		return retry.Permanent(errors.Wrap(err, "decode payload"))
+	}
+
+	// the expiration of the conversion time is passed: the notification is valid until the deadline of the item
+	if r, ok := payload.(provider.IExpiringRequest); ok && !item.Deadline.IsZero() {
+		r.SetExpiration(item.Deadline)
+	}
+
+	chOut, err := w.Send(ctx, &worker.Request{
+		Devices:       []string{item.DeviceToken},
+		CorrelationID: item.CorrelationID,
+		Payload:       payload,
+		Priority:      worker.Priority(item.Priority),
+	})
+	if err != nil {
+		// the worker queue is full: the notification is sent on the next attempt
+		return err
+	}
+
+	sendErr := errRetryNoResponse
+	for res := range chOut {
+		sendErr = res.Error
+	}
+
+	if sendErr == errRetryNoResponse || worker.IsRetryable(sendErr) {
+		return sendErr
+	}
+
+	if sendErr != nil && ctx.Err() != nil {
+		// the replay is canceled: the notification is sent on the next attempt
+		return sendErr
+	}
+
+	if sendErr != nil {
+		// the notification can't be delivered: for example, the device token is invalid
+		l.Warn("drop notification", zap.Int("attempt", item.Attempt), zap.Error(sendErr))
+	}
+
+	return nil
+}
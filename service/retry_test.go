@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/dialogs/dialog-push-service/pkg/provider"
+	"github.com/dialogs/dialog-push-service/pkg/provider/ans"
+	"github.com/dialogs/dialog-push-service/pkg/retry"
+	"github.com/dialogs/dialog-push-service/pkg/worker"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestReplay(t *testing.T) {
+
+	w := &replayWorker{}
+	impl := &implGRPC{
+		workers: map[string]worker.IWorker{"p-1": w},
+		logger:  zap.NewNop(),
+	}
+
+	payload, err := json.Marshal(&ans.Request{
+		Headers: ans.RequestHeader{Expiration: time.Now().Add(-time.Minute)},
+	})
+	require.NoError(t, err)
+
+	item := &retry.Item{
+		ProjectID:   "p-1",
+		DeviceToken: "token",
+		Payload:     payload,
+		Deadline:    time.Now().Add(time.Hour).Truncate(time.Second),
+	}
+
+	// the expiration of the notification is the deadline of the item
+	require.NoError(t, impl.replay(context.Background(), item))
+	require.True(t, item.Deadline.Equal(w.payload.(*ans.Request).Headers.Expiration))
+
+	// the notification of the canceled replay is sent on the next attempt
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	w.err = &url.Error{Op: "Post", URL: "https://api.push.apple.com", Err: context.Canceled}
+	require.Equal(t, w.err, impl.replay(ctx, item))
+
+	// the canceled notification isn't retryable
+	require.NoError(t, impl.replay(context.Background(), item))
+}
+
+// replayWorker stores the payload of the request, the response has the error
+type replayWorker struct {
+	worker.IWorker
+	payload provider.IRequest
+	err     error
+}
+
+func (w *replayWorker) NewRequest() provider.IRequest {
+	return &ans.Request{}
+}
+
+func (w *replayWorker) Send(_ context.Context, req *worker.Request) (<-chan *worker.Response, error) {
+
+	w.payload = req.Payload
+
+	ch := make(chan *worker.Response, 1)
+	ch <- &worker.Response{ProjectID: "p-1", DeviceToken: req.Devices[0], Error: w.err}
+	close(ch)
+
+	return ch, nil
+}
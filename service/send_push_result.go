@@ -1,13 +1,104 @@
 package service
 
+import (
+	"sort"
+	"time"
+
+	"github.com/dialogs/dialog-push-service/pkg/api"
+	"github.com/dialogs/dialog-push-service/pkg/worker"
+	"google.golang.org/grpc/status"
+)
+
 type sendPushResult struct {
 	ProjectID           string
 	InvalidationDevices []string
+	Results             []*api.DeviceResult
+	// Replacements - old device token -> new device token
+	Replacements map[string]string
 }
 
 func newSendPushResult(projectID string) *sendPushResult {
 	return &sendPushResult{
 		ProjectID:           projectID,
 		InvalidationDevices: make([]string, 0),
+		Results:             make([]*api.DeviceResult, 0),
+		Replacements:        make(map[string]string),
+	}
+}
+
+// rejectAll reports the push as rejected for every device of the project
+func (r *sendPushResult) rejectAll(devices []string, err error) {
+
+	for _, device := range devices {
+		r.Results = append(r.Results, &api.DeviceResult{
+			DeviceId: device,
+			Status:   api.StatusPayloadRejected,
+			Reason:   err.Error(),
+		})
+	}
+}
+
+// newErrorResponse returns the response of the failed push
+func newErrorResponse(push *api.Push, err error) *api.Response {
+
+	st := status.Convert(err)
+
+	return &api.Response{
+		CorrelationId: push.CorrelationId,
+		Error: &api.PushError{
+			Code:    int32(st.Code()),
+			Message: st.Message(),
+		},
 	}
 }
+
+func newDeviceResult(res *worker.Response) *api.DeviceResult {
+
+	retval := &api.DeviceResult{
+		DeviceId:  res.DeviceToken,
+		Status:    api.StatusDelivered,
+		MessageId: res.MessageID,
+	}
+
+	if res.Error == nil {
+		return retval
+	}
+
+	retval.Status = api.StatusTransientFailure
+	retval.Reason = res.Error.Error()
+
+	if workerErr, ok := res.Error.(*worker.ResponseError); ok {
+		retval.Reason = workerErr.Err().Error()
+
+		switch workerErr.Code {
+		case worker.ErrorCodeBadDeviceToken:
+			retval.Status = api.StatusInvalidToken
+		case worker.ErrorCodeUnregistered:
+			retval.Status = api.StatusUnregistered
+			if !workerErr.Timestamp.IsZero() {
+				retval.UnregisteredAt = workerErr.Timestamp.UnixNano() / int64(time.Millisecond)
+			}
+		case worker.ErrorCodeTooManyRequests:
+			retval.Status = api.StatusRateLimited
+		case worker.ErrorCodeBadRequest:
+			retval.Status = api.StatusPayloadRejected
+		case worker.ErrorCodeCircuitOpen:
+			retval.Status = api.StatusProviderUnavailable
+		}
+	}
+
+	return retval
+}
+
+// sortResponses sorts the worker responses in order of the devices
+func sortResponses(responses []*worker.Response, devices []string) {
+
+	index := make(map[string]int, len(devices))
+	for i := len(devices) - 1; i >= 0; i-- {
+		index[devices[i]] = i
+	}
+
+	sort.SliceStable(responses, func(i, j int) bool {
+		return index[responses[i].DeviceToken] < index[responses[j].DeviceToken]
+	})
+}
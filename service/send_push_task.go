@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+
+	"github.com/dialogs/dialog-push-service/pkg/api"
+	"github.com/dialogs/dialog-push-service/pkg/worker"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// sendPushTask is the push for the devices of a project
+type sendPushTask struct {
+	projectID string
+	devices   []string
+	priority  worker.Priority
+	logger    *zap.Logger
+	// worker is nil if the project is unknown
+	worker worker.IWorker
+	// conversionErr is an error of the push conversion to the provider request
+	conversionErr error
+	retryPayload  []byte
+	// admission is the place of the request in the worker queue.
+	// Nil if the push isn't sent: for example, the payload is ignored
+	admission worker.Admission
+	// responses is nil if the push isn't sent
+	responses <-chan *worker.Response
+}
+
+// send sends the admitted request to the worker
+func (t *sendPushTask) send(ctx context.Context) {
+
+	if t.admission != nil {
+		t.responses = t.admission.Send(ctx)
+	}
+}
+
+// release releases the place of the admitted request: the push isn't sent
+func (t *sendPushTask) release() {
+
+	if t.admission != nil {
+		t.admission.Release()
+	}
+}
+
+// admissionError returns the gRPC status of the rejected push
+func admissionError(projectID string, err error) error {
+
+	switch err {
+	case worker.ErrQueueFull:
+		return status.Error(codes.ResourceExhausted, "project ID: "+projectID+": "+err.Error())
+	case context.Canceled:
+		return status.Error(codes.Canceled, err.Error())
+	case context.DeadlineExceeded:
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+
+	return err
+}
+
+// pushPriority returns the priority of the push. If the priority isn't set
+// explicitly, the priority is derived from the body: the calls are sent first
+func pushPriority(push *api.Push) worker.Priority {
+
+	switch push.GetPriority() {
+	case api.PriorityLow:
+		return worker.PriorityLow
+	case api.PriorityNormal:
+		return worker.PriorityNormal
+	case api.PriorityHigh:
+		return worker.PriorityHigh
+	}
+
+	body := push.GetBody()
+	switch {
+	case body.GetVoipPush() != nil:
+		return worker.PriorityHigh
+	case body.GetAlertingPush() != nil, body.GetEncryptedPush() != nil:
+		return worker.PriorityNormal
+	}
+
+	return worker.PriorityLow
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"crypto/tls"
 	"net"
 	"net/http"
 	"sync"
@@ -10,19 +11,31 @@ import (
 	"github.com/dialogs/dialog-go-lib/service"
 	httprouter "github.com/dialogs/dialog-go-lib/service/router"
 	"github.com/dialogs/dialog-push-service/pkg/api"
+	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/spf13/viper"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 )
 
 type Service struct {
-	implGRPC      *implGRPC
-	logger        *zap.Logger
-	apiPort       string
-	adminPort     string
-	ctxDone       context.Context
-	ctxDoneCancel func()
+	implGRPC        *implGRPC
+	health          *healthChecker
+	reloader        *reloader
+	projectAdmin    *projectAdmin
+	rest            *restGateway
+	logger          *zap.Logger
+	apiPort         string
+	adminPort       string
+	restPort        string
+	shutdownTimeout time.Duration
+	grpcOptions     []grpc.ServerOption
+	tlsConfig       *tls.Config
+	ctxDone         context.Context
+	ctxDoneCancel   func()
 }
 
 func New(cfg *viper.Viper, logger *zap.Logger) (*Service, error) {
@@ -37,29 +50,88 @@ func New(cfg *viper.Viper, logger *zap.Logger) (*Service, error) {
 		return nil, err
 	}
 
+	auth, err := newAuthorizer(c.Clients)
+	if err != nil {
+		return nil, errors.Wrap(err, "clients")
+	}
+
+	grpcOptions := []grpc.ServerOption{
+		grpc.UnaryInterceptor(auth.unaryInterceptor),
+		grpc.StreamInterceptor(auth.streamInterceptor),
+	}
+
+	var tlsConfig *tls.Config
+	if c.GRPCTLS != nil {
+		tlsConfig, err = c.GRPCTLS.ServerConfig()
+		if err != nil {
+			return nil, errors.Wrap(err, "grpc-tls")
+		}
+
+		grpcOptions = append(grpcOptions, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+
+	shutdownTimeout := c.ShutdownTimeout
+	if shutdownTimeout <= 0 {
+		shutdownTimeout = DefaultShutdownTimeout
+	}
+
+	var store *projectStore
+	if c.ProjectsDir != "" {
+		store, err = openProjectStore(c.ProjectsDir)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	reloader, err := newReloader(cfg, c, grpcImpl, store, shutdownTimeout, logger)
+	if err != nil {
+		return nil, err
+	}
+
 	ctxDone, ctxDoneCancel := context.WithCancel(context.Background())
 
 	return &Service{
-		implGRPC:      grpcImpl,
-		logger:        logger,
-		apiPort:       c.ApiPort,
-		adminPort:     c.AdminPort,
-		ctxDone:       ctxDone,
-		ctxDoneCancel: ctxDoneCancel,
+		implGRPC:        grpcImpl,
+		health:          newHealthChecker(grpcImpl.currentWorkers, logger),
+		reloader:        reloader,
+		projectAdmin:    newProjectAdmin(reloader, auth, logger),
+		rest:            newRESTGateway(grpcImpl, auth, logger),
+		logger:          logger,
+		apiPort:         c.ApiPort,
+		adminPort:       c.AdminPort,
+		restPort:        c.RestPort,
+		shutdownTimeout: shutdownTimeout,
+		grpcOptions:     grpcOptions,
+		tlsConfig:       tlsConfig,
+		ctxDone:         ctxDone,
+		ctxDoneCancel:   ctxDoneCancel,
 	}, nil
 }
 
+// Close starts the shutdown of the service: Run returns after the pushes in flight are drained
 func (s *Service) Close() error {
 	s.ctxDoneCancel()
 	return nil
 }
 
+// Reload re-reads the config file and applies the changed projects without the restart
+func (s *Service) Reload() error {
+
+	_, err := s.reloader.reload()
+	return err
+}
+
 func (s *Service) Run() error {
 
-	var wgAdminSvc, wgApiSvc sync.WaitGroup
+	var wgAdminSvc, wgApiSvc, wgRestSvc sync.WaitGroup
+
+	// the connections of the providers are closed after the servers
+	defer s.implGRPC.close()
 
 	adminRouter := httprouter.NewAdminRouter(Info())
 	adminRouter.Handle("/metrics", promhttp.Handler())
+	adminRouter.Handle(reloadPath, http.HandlerFunc(s.projectAdmin.ServeReload))
+	adminRouter.Handle(projectsPath, s.projectAdmin)
 
 	adminSvc := service.NewHTTP(adminRouter, time.Second)
 	defer func() {
@@ -69,7 +141,7 @@ func (s *Service) Run() error {
 		wgAdminSvc.Wait()
 	}()
 
-	apiSvc := service.NewGRPC()
+	apiSvc := service.NewGRPC(s.grpcOptions...)
 	defer func() {
 		if err := apiSvc.Close(); err != nil {
 			s.logger.Error("close API service", zap.Error(err))
@@ -77,6 +149,17 @@ func (s *Service) Run() error {
 		wgApiSvc.Wait()
 	}()
 
+	restSvc := &http.Server{
+		Handler:   s.rest,
+		TLSConfig: s.tlsConfig,
+	}
+	defer func() {
+		if err := restSvc.Close(); err != nil {
+			s.logger.Error("close REST gateway", zap.Error(err))
+		}
+		wgRestSvc.Wait()
+	}()
+
 	retval := make(chan error, 3)
 
 	wgAdminSvc.Add(1)
@@ -99,6 +182,9 @@ func (s *Service) Run() error {
 
 		apiSvc.RegisterService(func(grpcSvr *grpc.Server) {
 			api.RegisterPushingServer(grpcSvr, s.implGRPC)
+			api.RegisterProjectAdminServer(grpcSvr, s.projectAdmin)
+			healthpb.RegisterHealthServer(grpcSvr, s.health.server)
+			reflection.Register(grpcSvr)
 		})
 		err := apiSvc.ListenAndServeAddr(address)
 		if err != nil && err != http.ErrServerClosed {
@@ -107,8 +193,35 @@ func (s *Service) Run() error {
 		retval <- err
 	}()
 
+	if s.restPort != "" {
+		wgRestSvc.Add(1)
+		go func() {
+			defer wgRestSvc.Done()
+
+			restSvc.Addr = net.JoinHostPort("0.0.0.0", s.restPort)
+
+			var err error
+			if restSvc.TLSConfig != nil {
+				err = restSvc.ListenAndServeTLS("", "")
+			} else {
+				err = restSvc.ListenAndServe()
+			}
+			if err != nil && err != http.ErrServerClosed {
+				s.logger.Error("REST gateway closed", zap.Error(err))
+			}
+			retval <- err
+		}()
+	}
+
+	go s.health.run(s.ctxDone)
+
+	if s.implGRPC.retry != nil {
+		go s.implGRPC.retry.Run(s.ctxDone, s.implGRPC.replay)
+	}
+
 	go func() {
 		<-s.ctxDone.Done()
+		s.shutdown()
 		if err := apiSvc.Close(); err != nil {
 			s.logger.Error("failed to close API service", zap.Error(err))
 		}
@@ -116,3 +229,17 @@ func (s *Service) Run() error {
 
 	return <-retval
 }
+
+// shutdown drains the service before the API is closed: the health status is NOT_SERVING,
+// the new pushes are rejected and the pushes in flight are finished or canceled by the timeout
+func (s *Service) shutdown() {
+
+	s.logger.Info("shutdown: drain", zap.Duration("timeout", s.shutdownTimeout))
+	s.health.shutdown()
+
+	if err := s.implGRPC.drainer.drain(s.shutdownTimeout); err != nil {
+		s.logger.Warn("shutdown", zap.Error(err))
+	}
+
+	s.logger.Info("shutdown: drained")
+}
@@ -16,11 +16,28 @@ import (
 	"github.com/dialogs/dialog-go-lib/service"
 	"github.com/dialogs/dialog-push-service/pkg/api"
 	"github.com/dialogs/dialog-push-service/pkg/test"
+	"github.com/dialogs/dialog-push-service/pkg/worker"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
+)
+
+// device tokens for the fake provider servers
+const (
+	androidToken = "android-token"
+	iosToken     = "ios-token"
+	// the application was uninstalled
+	uninstalledToken = "uninstalled-token"
+	// the token was changed to androidToken (legacy FCM only)
+	staleToken = "stale-token"
+	// the request rate is exceeded: the notification is sent again
+	limitedToken = "limited-token"
 )
 
 func init() {
@@ -40,7 +57,33 @@ func TestService(t *testing.T) {
 		t.Fatal("invalid data size:" + apiPort)
 	}
 
-	cfgPath := saveServiceConfig(t, apiPort)
+	fcmServer := test.NewFCMServer("fcm-project")
+	defer fcmServer.Close()
+
+	gcmServer := test.NewGCMServer("server-key")
+	defer gcmServer.Close()
+
+	apnsServer := test.NewAPNsServer()
+	defer apnsServer.Close()
+
+	for _, srv := range []interface {
+		SetDefaultReply(test.Reply)
+		SetReply(string, test.Reply)
+	}{fcmServer, gcmServer, apnsServer} {
+		srv.SetDefaultReply(test.ReplyBadDeviceToken)
+		srv.SetReply(androidToken, test.ReplySuccess)
+		srv.SetReply(iosToken, test.ReplySuccess)
+		srv.SetReply(uninstalledToken, test.ReplyUnregistered)
+	}
+
+	gcmServer.SetReply(staleToken, test.ReplySuccess)
+	gcmServer.SetReply(limitedToken, test.ReplyTooManyRequests)
+	gcmServer.SetCanonicalID(staleToken, androidToken)
+
+	files := newServiceFiles(t, fcmServer, gcmServer, apnsServer)
+	defer files.Remove(t)
+
+	cfgPath := saveServiceConfig(t, apiPort, files)
 	defer func() { require.NoError(t, os.Remove(cfgPath)) }()
 
 	v := viper.New()
@@ -81,6 +124,14 @@ func TestService(t *testing.T) {
 			Name: "ping",
 			Func: func(*testing.T) { testPing(t, conn) },
 		},
+		{
+			Name: "health",
+			Func: func(*testing.T) { testHealth(t, conn) },
+		},
+		{
+			Name: "reflection",
+			Func: func(*testing.T) { testReflection(t, conn) },
+		},
 		{
 			Name: "single push: invalid incoming data",
 			Func: func(*testing.T) { testSinglePushInvalidIncomigData(t, conn) },
@@ -89,6 +140,14 @@ func TestService(t *testing.T) {
 			Name: "single push: success",
 			Func: func(*testing.T) { testSinglePushSuccess(t, conn) },
 		},
+		{
+			Name: "single push: token replacements",
+			Func: func(*testing.T) { testSinglePushReplacements(t, conn) },
+		},
+		{
+			Name: "single push: retry",
+			Func: func(*testing.T) { testSinglePushRetry(t, conn, svc, gcmServer) },
+		},
 		{
 			Name: "push stream: invalid incoming data",
 			Func: func(*testing.T) { testPushStreamInvalidIncomigData(t, conn) },
@@ -109,8 +168,7 @@ func testPushStreamSuccess(t *testing.T, conn *grpc.ClientConn) {
 
 	client := api.NewPushingClient(conn)
 
-	android, ios, err := test.GetPushDevices()
-	require.NoError(t, err)
+	android, ios := androidToken, iosToken
 
 	stream, err := client.PushStream(context.Background())
 	require.NoError(t, err)
@@ -130,7 +188,8 @@ func testPushStreamSuccess(t *testing.T, conn *grpc.ClientConn) {
 
 	for i := 0; i < CountSend; i++ {
 		require.NoError(t, stream.Send(&api.Push{
-			Destinations: destinations,
+			Destinations:  destinations,
+			CorrelationId: "push-" + strconv.Itoa(i),
 			Body: &api.PushBody{
 				Body: &api.PushBody_EncryptedPush{
 					EncryptedPush: &api.EncryptedPush{
@@ -141,45 +200,53 @@ func testPushStreamSuccess(t *testing.T, conn *grpc.ClientConn) {
 		}))
 	}
 
-	countDestinations := len(destinations) - 1 // -1 - exclude results with unknown project
-
-	for i := 0; i < CountSend*countDestinations; i++ {
+	// every push is acknowledged once with the results of all projects
+	correlationIDs := make([]string, 0, CountSend)
+	for i := 0; i < CountSend; i++ {
 		res, err := stream.Recv()
 		require.NoError(t, err)
+		require.Nil(t, res.Error)
 
-		for projectID := range res.ProjectInvalidations {
-			switch projectID {
-			case "p-fcm":
-				require.Equal(t,
-					&api.Response{
-						ProjectInvalidations: map[string]*api.DeviceIdList{
-							"p-fcm": &api.DeviceIdList{DeviceIds: []string{"token1", "token2"}},
-						},
-					},
-					res)
-
-			case "p-gcm":
-				require.Equal(t,
-					&api.Response{
-						ProjectInvalidations: map[string]*api.DeviceIdList{
-							"p-gcm": &api.DeviceIdList{DeviceIds: []string{"token3", "token4"}},
-						},
-					},
-					res)
-			case "p-apple":
-				require.Equal(t,
-					&api.Response{
-						ProjectInvalidations: map[string]*api.DeviceIdList{
-							"p-apple": &api.DeviceIdList{DeviceIds: []string{"token5", "token6"}},
-						},
-					},
-					res)
-			default:
-				t.Fatal("invalid project" + projectID)
-			}
-		}
+		correlationIDs = append(correlationIDs, res.CorrelationId)
+
+		require.Equal(t,
+			map[string]*api.DeviceIdList{
+				"p-fcm":     &api.DeviceIdList{DeviceIds: []string{"token1", "token2"}},
+				"p-gcm":     &api.DeviceIdList{DeviceIds: []string{"token3", "token4"}},
+				"p-apple":   &api.DeviceIdList{DeviceIds: []string{"token5", "token6"}},
+				"p-unknown": &api.DeviceIdList{},
+			},
+			res.ProjectInvalidations)
+
+		requireResults(t,
+			map[string][]*api.DeviceResult{
+				"p-fcm": {
+					{DeviceId: "token1", Status: api.StatusInvalidToken},
+					{DeviceId: android, Status: api.StatusDelivered},
+					{DeviceId: "token2", Status: api.StatusInvalidToken},
+				},
+				"p-gcm": {
+					{DeviceId: "token3", Status: api.StatusInvalidToken},
+					{DeviceId: android, Status: api.StatusDelivered},
+					{DeviceId: "token4", Status: api.StatusInvalidToken},
+				},
+				"p-apple": {
+					{DeviceId: "token5", Status: api.StatusInvalidToken},
+					{DeviceId: ios, Status: api.StatusDelivered},
+					{DeviceId: "token6", Status: api.StatusInvalidToken},
+				},
+				"p-unknown": {
+					{DeviceId: "token7", Status: api.StatusPayloadRejected},
+					{DeviceId: android, Status: api.StatusPayloadRejected},
+					{DeviceId: ios, Status: api.StatusPayloadRejected},
+					{DeviceId: "token8", Status: api.StatusPayloadRejected},
+				},
+			},
+			res.Results)
 	}
 
+	require.ElementsMatch(t, []string{"push-0", "push-1", "push-2"}, correlationIDs)
+
 	checkStreamEnd(t, stream)
 }
 
@@ -187,8 +254,7 @@ func testPushStreamInvalidIncomigData(t *testing.T, conn *grpc.ClientConn) {
 
 	client := api.NewPushingClient(conn)
 
-	android, ios, err := test.GetPushDevices()
-	require.NoError(t, err)
+	android, ios := androidToken, iosToken
 
 	stream, err := client.PushStream(context.Background())
 	require.NoError(t, err)
@@ -210,6 +276,30 @@ func testPushStreamInvalidIncomigData(t *testing.T, conn *grpc.ClientConn) {
 		require.NoError(t, stream.Send(push))
 	}
 
+	for i := 0; i < 3; i++ {
+		res, err := stream.Recv()
+		require.NoError(t, err)
+		require.Len(t, res.Results, 4)
+		// the push isn't sent to the unknown project
+		require.Len(t, res.Results["p-unknown"].GetResults(), 5)
+		for _, deviceRes := range res.Results["p-unknown"].GetResults() {
+			require.Equal(t, api.StatusPayloadRejected, deviceRes.Status)
+			require.Equal(t, "invalid project ID", deviceRes.Reason)
+		}
+
+		for projectID, list := range res.Results {
+			if projectID == "p-unknown" {
+				continue
+			}
+
+			require.Len(t, list.Results, 3, projectID)
+			for _, deviceRes := range list.Results {
+				require.Equal(t, api.StatusPayloadRejected, deviceRes.Status)
+			}
+			require.Empty(t, res.ProjectInvalidations[projectID].GetDeviceIds())
+		}
+	}
+
 	checkStreamEnd(t, stream)
 }
 
@@ -217,16 +307,13 @@ func testSinglePushSuccess(t *testing.T, conn *grpc.ClientConn) {
 
 	client := api.NewPushingClient(conn)
 
-	android, ios, err := test.GetPushDevices()
-	require.NoError(t, err)
-	require.NotEmpty(t, ios)
-	require.NotEmpty(t, android)
+	android, ios, uninstalled := androidToken, iosToken, uninstalledToken
 
 	res, err := client.SinglePush(context.Background(), &api.Push{
 		Destinations: map[string]*api.DeviceIdList{
-			"p-fcm":     &api.DeviceIdList{DeviceIds: []string{"", "-", android, "token1"}},
-			"p-gcm":     &api.DeviceIdList{DeviceIds: []string{"", "-", android, "token2"}},
-			"p-apple":   &api.DeviceIdList{DeviceIds: []string{"", "-", ios, "token3"}},
+			"p-fcm":     &api.DeviceIdList{DeviceIds: []string{"", "-", android, "token1", uninstalled}},
+			"p-gcm":     &api.DeviceIdList{DeviceIds: []string{"", "-", android, "token2", uninstalled}},
+			"p-apple":   &api.DeviceIdList{DeviceIds: []string{"", "-", ios, "token3", uninstalled}},
 			"p-unknown": &api.DeviceIdList{DeviceIds: []string{"", "-", android, ios, "token4"}},
 		},
 		Body: &api.PushBody{
@@ -240,23 +327,132 @@ func testSinglePushSuccess(t *testing.T, conn *grpc.ClientConn) {
 
 	require.NoError(t, err)
 	require.Equal(t,
-		&api.Response{
-			ProjectInvalidations: map[string]*api.DeviceIdList{
-				"p-fcm":     &api.DeviceIdList{DeviceIds: []string{"", "-", "token1"}},
-				"p-gcm":     &api.DeviceIdList{DeviceIds: []string{"", "-", "token2"}},
-				"p-apple":   &api.DeviceIdList{DeviceIds: []string{"", "-", "token3"}},
-				"p-unknown": &api.DeviceIdList{},
+		map[string]*api.DeviceIdList{
+			"p-fcm":     &api.DeviceIdList{DeviceIds: []string{"", "-", "token1", uninstalled}},
+			"p-gcm":     &api.DeviceIdList{DeviceIds: []string{"", "-", "token2", uninstalled}},
+			"p-apple":   &api.DeviceIdList{DeviceIds: []string{"", "-", "token3", uninstalled}},
+			"p-unknown": &api.DeviceIdList{},
+		},
+		res.ProjectInvalidations)
+
+	requireResults(t,
+		map[string][]*api.DeviceResult{
+			"p-fcm": {
+				{DeviceId: "", Status: api.StatusInvalidToken},
+				{DeviceId: "-", Status: api.StatusInvalidToken},
+				{DeviceId: android, Status: api.StatusDelivered},
+				{DeviceId: "token1", Status: api.StatusInvalidToken},
+				{DeviceId: uninstalled, Status: api.StatusUnregistered},
+			},
+			"p-gcm": {
+				{DeviceId: "", Status: api.StatusInvalidToken},
+				{DeviceId: "-", Status: api.StatusInvalidToken},
+				{DeviceId: android, Status: api.StatusDelivered},
+				{DeviceId: "token2", Status: api.StatusInvalidToken},
+				{DeviceId: uninstalled, Status: api.StatusUnregistered},
+			},
+			"p-apple": {
+				{DeviceId: "", Status: api.StatusInvalidToken},
+				{DeviceId: "-", Status: api.StatusInvalidToken},
+				{DeviceId: ios, Status: api.StatusDelivered},
+				{DeviceId: "token3", Status: api.StatusInvalidToken},
+				{DeviceId: uninstalled, Status: api.StatusUnregistered},
+			},
+			"p-unknown": {
+				{DeviceId: "", Status: api.StatusPayloadRejected},
+				{DeviceId: "-", Status: api.StatusPayloadRejected},
+				{DeviceId: android, Status: api.StatusPayloadRejected},
+				{DeviceId: ios, Status: api.StatusPayloadRejected},
+				{DeviceId: "token4", Status: api.StatusPayloadRejected},
 			},
 		},
-		res)
+		res.Results)
+
+	require.Equal(t, "empty device token", res.Results["p-apple"].Results[0].Reason)
+	require.Equal(t, "400 BadDeviceToken", res.Results["p-apple"].Results[1].Reason)
+	require.Equal(t, "InvalidRegistration", res.Results["p-gcm"].Results[3].Reason)
+
+	// APNs reports the last time when the token was valid
+	require.Equal(t, "410 Unregistered", res.Results["p-apple"].Results[4].Reason)
+	require.NotZero(t, res.Results["p-apple"].Results[4].UnregisteredAt)
+	require.Equal(t, "NotRegistered", res.Results["p-gcm"].Results[4].Reason)
+	require.Zero(t, res.Results["p-gcm"].Results[4].UnregisteredAt)
+	require.Zero(t, res.Results["p-fcm"].Results[4].UnregisteredAt)
 }
 
-func testSinglePushInvalidIncomigData(t *testing.T, conn *grpc.ClientConn) {
+func testSinglePushReplacements(t *testing.T, conn *grpc.ClientConn) {
 
 	client := api.NewPushingClient(conn)
 
-	android, ios, err := test.GetPushDevices()
+	res, err := client.SinglePush(context.Background(), &api.Push{
+		Destinations: map[string]*api.DeviceIdList{
+			"p-fcm": &api.DeviceIdList{DeviceIds: []string{androidToken}},
+			"p-gcm": &api.DeviceIdList{DeviceIds: []string{staleToken, androidToken}},
+		},
+		Body: &api.PushBody{
+			Body: &api.PushBody_EncryptedPush{
+				EncryptedPush: &api.EncryptedPush{
+					EncryptedData: []byte("push body"),
+				},
+			},
+		},
+	})
+
 	require.NoError(t, err)
+	require.Equal(t,
+		map[string]*api.TokenReplacements{
+			"p-gcm": &api.TokenReplacements{
+				Tokens: map[string]string{staleToken: androidToken},
+			},
+		},
+		res.ProjectReplacements)
+}
+
+func testSinglePushRetry(t *testing.T, conn *grpc.ClientConn, svc *Service, gcmServer *test.GCMServer) {
+
+	client := api.NewPushingClient(conn)
+
+	res, err := client.SinglePush(context.Background(), &api.Push{
+		Destinations: map[string]*api.DeviceIdList{
+			"p-gcm": &api.DeviceIdList{DeviceIds: []string{limitedToken}},
+		},
+		Body: &api.PushBody{
+			TimeToLive: 60,
+			Body: &api.PushBody_EncryptedPush{
+				EncryptedPush: &api.EncryptedPush{
+					EncryptedData: []byte("push body"),
+				},
+			},
+		},
+		CorrelationId: "retry-1",
+	})
+
+	require.NoError(t, err)
+	requireResults(t,
+		map[string][]*api.DeviceResult{
+			"p-gcm": {{DeviceId: limitedToken, Status: api.StatusRetryQueued}},
+		},
+		res.Results)
+	require.Equal(t, 1, svc.implGRPC.retry.Len())
+
+	// the provider accepts the notification on the next attempts
+	gcmServer.SetReply(limitedToken, test.ReplySuccess)
+
+	for deadline := time.Now().Add(5 * time.Second); svc.implGRPC.retry.Len() > 0; {
+		require.True(t, time.Now().Before(deadline), "retry queue isn't empty")
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	deadLetters, err := svc.implGRPC.retry.DeadLetters()
+	require.NoError(t, err)
+	require.Empty(t, deadLetters)
+}
+
+func testSinglePushInvalidIncomigData(t *testing.T, conn *grpc.ClientConn) {
+
+	client := api.NewPushingClient(conn)
+
+	android, ios := androidToken, iosToken
 
 	res, err := client.SinglePush(context.Background(), &api.Push{
 		Destinations: map[string]*api.DeviceIdList{
@@ -269,15 +465,54 @@ func testSinglePushInvalidIncomigData(t *testing.T, conn *grpc.ClientConn) {
 
 	require.NoError(t, err)
 	require.Equal(t,
-		&api.Response{
-			ProjectInvalidations: map[string]*api.DeviceIdList{
-				"p-fcm":     &api.DeviceIdList{},
-				"p-gcm":     &api.DeviceIdList{},
-				"p-apple":   &api.DeviceIdList{},
-				"p-unknown": &api.DeviceIdList{},
-			},
+		map[string]*api.DeviceIdList{
+			"p-fcm":     &api.DeviceIdList{},
+			"p-gcm":     &api.DeviceIdList{},
+			"p-apple":   &api.DeviceIdList{},
+			"p-unknown": &api.DeviceIdList{},
+		},
+		res.ProjectInvalidations)
+
+	rejected := func(devices ...string) []*api.DeviceResult {
+		retval := make([]*api.DeviceResult, 0, len(devices))
+		for _, device := range devices {
+			retval = append(retval, &api.DeviceResult{DeviceId: device, Status: api.StatusPayloadRejected})
+		}
+		return retval
+	}
+
+	requireResults(t,
+		map[string][]*api.DeviceResult{
+			"p-fcm":     rejected("token1", android, "token2"),
+			"p-gcm":     rejected("token3", android, "token4"),
+			"p-apple":   rejected("token5", ios, "token6"),
+			"p-unknown": rejected("token7", android, ios, "token8"),
 		},
-		res)
+		res.Results)
+}
+
+// requireResults compares device IDs and statuses of the delivery results.
+// The delivered notifications must have a message ID
+func requireResults(t *testing.T, expected map[string][]*api.DeviceResult, actual map[string]*api.DeviceResultList) {
+	t.Helper()
+
+	retval := make(map[string][]*api.DeviceResult, len(actual))
+	for projectID, list := range actual {
+		var results []*api.DeviceResult
+		for _, res := range list.GetResults() {
+			if res.Status == api.StatusDelivered {
+				require.NotEmpty(t, res.MessageId, "%s: %s", projectID, res.DeviceId)
+			} else {
+				require.NotEmpty(t, res.Reason, "%s: %s", projectID, res.DeviceId)
+			}
+
+			results = append(results, &api.DeviceResult{DeviceId: res.DeviceId, Status: res.Status})
+		}
+
+		retval[projectID] = results
+	}
+
+	require.Equal(t, expected, retval)
 }
 
 func testPing(t *testing.T, conn *grpc.ClientConn) {
@@ -289,18 +524,115 @@ func testPing(t *testing.T, conn *grpc.ClientConn) {
 	require.Equal(t, &api.PongResponse{}, res)
 }
 
-func saveServiceConfig(t *testing.T, apiPort string) string {
+func testHealth(t *testing.T, conn *grpc.ClientConn) {
+
+	client := healthpb.NewHealthClient(conn)
+
+	for _, service := range []string{"", "main.Pushing", "p-fcm", "p-gcm", "p-apple"} {
+		res, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+		require.NoError(t, err)
+		require.Equal(t, healthpb.HealthCheckResponse_SERVING, res.Status, service)
+	}
+
+	_, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{Service: "p-unknown"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func testReflection(t *testing.T, conn *grpc.ClientConn) {
+
+	stream, err := reflectionpb.NewServerReflectionClient(conn).ServerReflectionInfo(context.Background())
+	require.NoError(t, err)
+	defer func() { require.NoError(t, stream.CloseSend()) }()
+
+	require.NoError(t, stream.Send(&reflectionpb.ServerReflectionRequest{
+		MessageRequest: &reflectionpb.ServerReflectionRequest_ListServices{},
+	}))
+
+	res, err := stream.Recv()
+	require.NoError(t, err)
+
+	services := make([]string, 0)
+	for _, item := range res.GetListServicesResponse().GetService() {
+		services = append(services, item.Name)
+	}
+	require.Contains(t, services, "main.Pushing")
+	require.Contains(t, services, "grpc.health.v1.Health")
+
+	require.NoError(t, stream.Send(&reflectionpb.ServerReflectionRequest{
+		MessageRequest: &reflectionpb.ServerReflectionRequest_FileContainingSymbol{
+			FileContainingSymbol: "main.Pushing",
+		},
+	}))
+
+	res, err = stream.Recv()
+	require.NoError(t, err)
+	require.Nil(t, res.GetErrorResponse())
+	require.NotEmpty(t, res.GetFileDescriptorResponse().GetFileDescriptorProto())
+}
+
+// serviceFiles is a set of credentials for the fake provider servers
+type serviceFiles struct {
+	ApplePem          string
+	AppleEndpoint     string
+	AppleCA           string
+	FcmServiceAccount string
+	FcmEndpoint       string
+	FcmCA             string
+	GcmKey            string
+	GcmEndpoint       string
+	GcmCA             string
+	RetryDir          string
+}
+
+func newServiceFiles(t *testing.T, fcmServer *test.FCMServer, gcmServer *test.GCMServer, apnsServer *test.APNsServer) *serviceFiles {
 	t.Helper()
 
-	applePem, err := test.GetPathToIOSCertificatePem()
+	applePem, err := test.NewAppleCertificatePem(test.AppleCertificate{
+		Production: true,
+		Topics:     []string{"im.dlg.test"},
+	})
 	require.NoError(t, err)
 
-	gcmKey, err := test.GetAccountKey()
+	serviceAccount, err := fcmServer.ServiceAccount()
 	require.NoError(t, err)
 
-	fcmServiceAccount, err := test.GetPathToGoogleServiceAccount()
+	files := &serviceFiles{
+		AppleEndpoint: apnsServer.URL,
+		FcmEndpoint:   fcmServer.URL,
+		GcmKey:        "server-key",
+		GcmEndpoint:   gcmServer.URL,
+	}
+
+	for path, data := range map[*string][]byte{
+		&files.ApplePem:          applePem,
+		&files.AppleCA:           test.CAPem(apnsServer.Server),
+		&files.FcmServiceAccount: serviceAccount,
+		&files.FcmCA:             test.CAPem(fcmServer.Server),
+		&files.GcmCA:             test.CAPem(gcmServer.Server),
+	} {
+		*path, err = test.SaveTempFile(data, "service")
+		require.NoError(t, err)
+	}
+
+	files.RetryDir, err = ioutil.TempDir("", "service-retry")
 	require.NoError(t, err)
 
+	return files
+}
+
+func (f *serviceFiles) Remove(t *testing.T) {
+	t.Helper()
+
+	for _, path := range []string{f.ApplePem, f.AppleCA, f.FcmServiceAccount, f.FcmCA, f.GcmCA} {
+		require.NoError(t, os.Remove(path))
+	}
+
+	require.NoError(t, os.RemoveAll(f.RetryDir))
+}
+
+func saveServiceConfig(t *testing.T, apiPort string, files *serviceFiles) string {
+	t.Helper()
+
 	apiPortInt, err := strconv.Atoi(apiPort)
 	require.NoError(t, err)
 
@@ -311,20 +643,32 @@ grpc-port: ` + apiPort + `
 http-port: ` + adminPort + `
 fcm:
   - project-id: p-fcm
-    service-account: ` + fcmServiceAccount + `
+    service-account: ` + files.FcmServiceAccount + `
+    endpoint: ` + files.FcmEndpoint + `
+    ca-file: ` + files.FcmCA + `
     send-tries: 10
     send-timeout: 2s
     allow-alerts: true
 google:
   - project-id: p-gcm
-    key: ` + string(gcmKey) + `
+    key: ` + files.GcmKey + `
+    endpoint: ` + files.GcmEndpoint + `
+    ca-file: ` + files.GcmCA + `
     retries: 10
+    retry-base-delay: 1ms
+    retry-max-delay: 10ms
     allow-alerts: true
 apple:
   - project-id: p-apple
     allow-alerts: true
-    pem: ` + applePem + `
+    pem: ` + files.ApplePem + `
+    endpoint: ` + files.AppleEndpoint + `
+    ca-file: ` + files.AppleCA + `
     sound: "dialog.wav"
+retry:
+  dir: ` + files.RetryDir + `
+  base-delay: 100ms
+  poll-interval: 50ms
 `
 
 	const file = "config.yaml"
@@ -377,3 +721,77 @@ func checkStreamEnd(t *testing.T, stream api.Pushing_PushStreamClient) {
 
 	require.Equal(t, io.EOF, <-ch)
 }
+
+func TestPushPriority(t *testing.T) {
+
+	for _, testCase := range []struct {
+		push     *api.Push
+		priority worker.Priority
+	}{
+		{
+			push:     &api.Push{},
+			priority: worker.PriorityLow,
+		},
+		{
+			push:     &api.Push{Body: &api.PushBody{Body: &api.PushBody_SilentPush{SilentPush: &api.SilentPush{}}}},
+			priority: worker.PriorityLow,
+		},
+		{
+			push:     &api.Push{Body: &api.PushBody{Body: &api.PushBody_ReadPush{ReadPush: &api.ReadPush{}}}},
+			priority: worker.PriorityLow,
+		},
+		{
+			push:     &api.Push{Body: &api.PushBody{Body: &api.PushBody_AlertingPush{AlertingPush: &api.AlertingPush{}}}},
+			priority: worker.PriorityNormal,
+		},
+		{
+			push:     &api.Push{Body: &api.PushBody{Body: &api.PushBody_EncryptedPush{EncryptedPush: &api.EncryptedPush{}}}},
+			priority: worker.PriorityNormal,
+		},
+		{
+			push:     &api.Push{Body: &api.PushBody{Body: &api.PushBody_VoipPush{VoipPush: &api.VoipPush{}}}},
+			priority: worker.PriorityHigh,
+		},
+		{
+			push: &api.Push{
+				Body:     &api.PushBody{Body: &api.PushBody_SilentPush{SilentPush: &api.SilentPush{}}},
+				Priority: api.PriorityHigh,
+			},
+			priority: worker.PriorityHigh,
+		},
+		{
+			push: &api.Push{
+				Body:     &api.PushBody{Body: &api.PushBody_VoipPush{VoipPush: &api.VoipPush{}}},
+				Priority: api.PriorityLow,
+			},
+			priority: worker.PriorityLow,
+		},
+	} {
+		require.Equal(t, testCase.priority, pushPriority(testCase.push), testCase.push.String())
+	}
+}
+
+func TestNewErrorResponse(t *testing.T) {
+
+	push := &api.Push{CorrelationId: "push-1"}
+
+	require.Equal(t,
+		&api.Response{
+			CorrelationId: "push-1",
+			Error: &api.PushError{
+				Code:    int32(codes.ResourceExhausted),
+				Message: "project ID: p-1: 4 worker queue is full",
+			},
+		},
+		newErrorResponse(push, admissionError("p-1", worker.ErrQueueFull)))
+
+	require.Equal(t,
+		&api.Response{
+			CorrelationId: "push-1",
+			Error: &api.PushError{
+				Code:    int32(codes.Unknown),
+				Message: "invalid project ID",
+			},
+		},
+		newErrorResponse(push, errInvalidProjectID))
+}
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+)
+
+const (
+	// ClientAuthRequire rejects the clients without a valid certificate
+	ClientAuthRequire = "require"
+	// ClientAuthVerifyIfGiven verifies the certificate if the client sends it:
+	// the clients without a certificate are authenticated by the API keys
+	ClientAuthVerifyIfGiven = "verify-if-given"
+)
+
+// TLSConfig is the transport security of the gRPC server and the REST gateway.
+// If the client CA is set, the clients are authenticated by the certificates (mTLS).
+// TLS is disabled if the config is nil
+type TLSConfig struct {
+	CertFile     string `mapstructure:"cert-file"`
+	KeyFile      string `mapstructure:"key-file"`
+	ClientCAFile string `mapstructure:"client-ca-file"`
+	// ClientAuth is the policy of the client certificates: ClientAuthRequire (default)
+	// or ClientAuthVerifyIfGiven
+	ClientAuth string `mapstructure:"client-auth"`
+}
+
+// NewTLSConfig reads the TLS settings. A nil source returns nil: TLS is disabled
+func NewTLSConfig(src *viper.Viper) (*TLSConfig, error) {
+
+	if src == nil {
+		return nil, nil
+	}
+
+	c := &TLSConfig{}
+	if err := src.Unmarshal(c); err != nil {
+		return nil, err
+	}
+
+	if c.CertFile == "" {
+		return nil, errors.New("invalid `cert-file`")
+	}
+
+	if c.KeyFile == "" {
+		return nil, errors.New("invalid `key-file`")
+	}
+
+	switch c.ClientAuth {
+	case "":
+		c.ClientAuth = ClientAuthRequire
+	case ClientAuthRequire, ClientAuthVerifyIfGiven:
+	default:
+		return nil, errors.New("invalid `client-auth`: " + c.ClientAuth)
+	}
+
+	return c, nil
+}
+
+// ServerConfig returns the TLS config of the gRPC server and the REST gateway
+func (c *TLSConfig) ServerConfig() (*tls.Config, error) {
+
+	cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "server certificate")
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if c.ClientCAFile != "" {
+		data, err := ioutil.ReadFile(c.ClientCAFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "client CA")
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(data) {
+			return nil, errors.New("client CA: certificates not found")
+		}
+
+		tlsConfig.ClientCAs = pool
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+		if c.ClientAuth == ClientAuthVerifyIfGiven {
+			tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+		}
+	}
+
+	return tlsConfig, nil
+}
@@ -0,0 +1,230 @@
+package service
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dialogs/dialog-push-service/pkg/api"
+	"github.com/dialogs/dialog-push-service/pkg/test"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func TestServiceTLS(t *testing.T) {
+
+	ca, err := test.NewCA()
+	require.NoError(t, err)
+
+	address, stop := runTLSService(t, ca, map[string]interface{}{})
+	defer stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn := dialTLS(t, ctx, address, ca, "client-1", grpc.WithBlock())
+	defer conn.Close()
+
+	_, err = api.NewPushingClient(conn).Ping(ctx, &api.PingRequest{})
+	require.NoError(t, err)
+
+	// the client without a certificate
+	anonymousConn := dialTLS(t, ctx, address, ca, "")
+	defer anonymousConn.Close()
+
+	_, err = api.NewPushingClient(anonymousConn).Ping(ctx, &api.PingRequest{}, grpc.WaitForReady(false))
+	require.Error(t, err)
+}
+
+func TestServiceTLSVerifyIfGiven(t *testing.T) {
+
+	ca, err := test.NewCA()
+	require.NoError(t, err)
+
+	address, stop := runTLSService(t, ca, map[string]interface{}{
+		"client-auth": ClientAuthVerifyIfGiven,
+		"clients": []interface{}{
+			map[interface{}]interface{}{
+				"name":     "messenger",
+				"api-key":  "key-1",
+				"projects": []interface{}{allProjects},
+			},
+			map[interface{}]interface{}{
+				"name":     "calls",
+				"identity": "client-1",
+				"projects": []interface{}{allProjects},
+			},
+		},
+	})
+	defer stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// the client with a certificate
+	conn := dialTLS(t, ctx, address, ca, "client-1", grpc.WithBlock())
+	defer conn.Close()
+
+	_, err = api.NewPushingClient(conn).Ping(ctx, &api.PingRequest{})
+	require.NoError(t, err)
+
+	// the client with an API key shares the server
+	apiKeyConn := dialTLS(t, ctx, address, ca, "", grpc.WithBlock())
+	defer apiKeyConn.Close()
+
+	_, err = api.NewPushingClient(apiKeyConn).Ping(
+		metadata.AppendToOutgoingContext(ctx, apiKeyHeader, "key-1"),
+		&api.PingRequest{})
+	require.NoError(t, err)
+
+	// the client has neither a certificate nor an API key
+	_, err = api.NewPushingClient(apiKeyConn).Ping(ctx, &api.PingRequest{})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+// runTLSService starts the service with mTLS and returns the address of the gRPC server.
+// The options are added to the `grpc-tls` section, except the `clients` section
+func runTLSService(t *testing.T, ca *test.CA, options map[string]interface{}) (string, func()) {
+	t.Helper()
+
+	serverCert, serverKey, err := ca.NewServerCertificate()
+	require.NoError(t, err)
+
+	files := make([]string, 0)
+	saveFile := func(data []byte) string {
+		path, err := test.SaveTempFile(data, "tls")
+		require.NoError(t, err)
+		files = append(files, path)
+		return path
+	}
+
+	listener := newListener(t)
+	address := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	_, apiPort, err := net.SplitHostPort(address)
+	require.NoError(t, err)
+
+	apiPortInt, err := strconv.Atoi(apiPort)
+	require.NoError(t, err)
+
+	tlsOptions := map[string]interface{}{
+		"cert-file":      saveFile(serverCert),
+		"key-file":       saveFile(serverKey),
+		"client-ca-file": saveFile(ca.CertPem()),
+	}
+
+	v := viper.New()
+	for key, val := range options {
+		if key == "clients" {
+			v.Set(key, val)
+		} else {
+			tlsOptions[key] = val
+		}
+	}
+
+	v.Set("grpc-port", apiPort)
+	v.Set("http-port", strconv.Itoa(apiPortInt+1))
+	v.Set("grpc-tls", tlsOptions)
+
+	svc, err := New(v, getLogger(t))
+	require.NoError(t, err)
+
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		require.Equal(t, http.ErrServerClosed, svc.Run())
+	}()
+
+	return address, func() {
+		require.NoError(t, svc.Close())
+		wg.Wait()
+
+		for _, path := range files {
+			require.NoError(t, os.Remove(path))
+		}
+	}
+}
+
+// dialTLS connects to the server. The client certificate is issued by the CA
+// for the common name; the client hasn't a certificate if the name is empty
+func dialTLS(t *testing.T, ctx context.Context, address string, ca *test.CA, commonName string, opts ...grpc.DialOption) *grpc.ClientConn {
+	t.Helper()
+
+	rootCAs := x509.NewCertPool()
+	require.True(t, rootCAs.AppendCertsFromPEM(ca.CertPem()))
+
+	tlsConfig := &tls.Config{
+		RootCAs: rootCAs,
+	}
+
+	if commonName != "" {
+		certPem, keyPem, err := ca.NewClientCertificate(commonName)
+		require.NoError(t, err)
+
+		keyPair, err := tls.X509KeyPair(certPem, keyPem)
+		require.NoError(t, err)
+
+		tlsConfig.Certificates = []tls.Certificate{keyPair}
+	}
+
+	opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+
+	conn, err := grpc.DialContext(ctx, address, opts...)
+	require.NoError(t, err)
+
+	return conn
+}
+
+func TestClientIdentity(t *testing.T) {
+
+	ca, err := test.NewCA()
+	require.NoError(t, err)
+
+	certPem, keyPem, err := ca.NewClientCertificate("client-1")
+	require.NoError(t, err)
+
+	keyPair, err := tls.X509KeyPair(certPem, keyPem)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(keyPair.Certificate[0])
+	require.NoError(t, err)
+
+	handler := func(ctx context.Context, _ interface{}) (interface{}, error) {
+		return clientIdentity(ctx), nil
+	}
+
+	auth, err := newAuthorizer(nil)
+	require.NoError(t, err)
+
+	// insecure connection
+	identity, err := auth.unaryInterceptor(context.Background(), nil, nil, handler)
+	require.NoError(t, err)
+	require.Equal(t, anonymousClient, identity)
+
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		AuthInfo: credentials.TLSInfo{
+			State: tls.ConnectionState{
+				VerifiedChains: [][]*x509.Certificate{{cert}},
+			},
+		},
+	})
+
+	identity, err = auth.unaryInterceptor(ctx, nil, nil, handler)
+	require.NoError(t, err)
+	require.Equal(t, "client-1", identity)
+}